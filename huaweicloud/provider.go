@@ -414,14 +414,15 @@ func Provider() *schema.Provider {
 			"huaweicloud_cbh_instances": cbh.DataSourceCbhInstances(),
 			"huaweicloud_cbh_flavors":   cbh.DataSourceCbhFlavors(),
 
-			"huaweicloud_cce_addon_template":      cce.DataSourceAddonTemplate(),
-			"huaweicloud_cce_cluster":             cce.DataSourceCCEClusterV3(),
-			"huaweicloud_cce_clusters":            cce.DataSourceCCEClusters(),
-			"huaweicloud_cce_cluster_certificate": cce.DataSourceCCEClusterCertificate(),
-			"huaweicloud_cce_node":                cce.DataSourceNode(),
-			"huaweicloud_cce_nodes":               cce.DataSourceNodes(),
-			"huaweicloud_cce_node_pool":           cce.DataSourceCCENodePoolV3(),
-			"huaweicloud_cci_namespaces":          cci.DataSourceCciNamespaces(),
+			"huaweicloud_cce_addon_template":               cce.DataSourceAddonTemplate(),
+			"huaweicloud_cce_cluster":                      cce.DataSourceCCEClusterV3(),
+			"huaweicloud_cce_clusters":                     cce.DataSourceCCEClusters(),
+			"huaweicloud_cce_cluster_certificate":          cce.DataSourceCCEClusterCertificate(),
+			"huaweicloud_cce_cluster_openid_configuration": cce.DataSourceClusterOpenIDConfiguration(),
+			"huaweicloud_cce_node":                         cce.DataSourceNode(),
+			"huaweicloud_cce_nodes":                        cce.DataSourceNodes(),
+			"huaweicloud_cce_node_pool":                    cce.DataSourceCCENodePoolV3(),
+			"huaweicloud_cci_namespaces":                   cci.DataSourceCciNamespaces(),
 
 			"huaweicloud_ccm_private_certificate_export": ccm.DataSourceCcmPrivateCertificateExport(),
 
@@ -490,6 +491,7 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_dns_zones":      dns.DataSourceZones(),
 			"huaweicloud_dns_recordsets": dns.DataSourceRecordsets(),
+			"huaweicloud_dns_zone_file":  dns.DataSourceZoneFile(),
 
 			"huaweicloud_eg_custom_event_channels": eg.DataSourceCustomEventChannels(),
 			"huaweicloud_eg_custom_event_sources":  eg.DataSourceCustomEventSources(),
@@ -502,6 +504,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_er_instances":          er.DataSourceInstances(),
 			"huaweicloud_er_route_tables":       er.DataSourceRouteTables(),
 			"huaweicloud_er_availability_zones": er.DataSourceAvailabilityZones(),
+			"huaweicloud_er_effective_routes":   er.DataSourceEffectiveRoutes(),
 
 			"huaweicloud_evs_volumes":      evs.DataSourceEvsVolumesV2(),
 			"huaweicloud_evs_snapshots":    evs.DataSourceEvsSnapshots(),
@@ -659,14 +662,15 @@ func Provider() *schema.Provider {
 			"huaweicloud_global_eip_pools":        eip.DataSourceGlobalEIPPools(),
 			"huaweicloud_global_eip_access_sites": eip.DataSourceGlobalEIPAccessSites(),
 
-			"huaweicloud_vpc":                    vpc.DataSourceVpcV1(),
-			"huaweicloud_vpcs":                   vpc.DataSourceVpcs(),
-			"huaweicloud_vpc_ids":                vpc.DataSourceVpcIdsV1(),
-			"huaweicloud_vpc_peering_connection": vpc.DataSourceVpcPeeringConnectionV2(),
-			"huaweicloud_vpc_route_table":        vpc.DataSourceVPCRouteTable(),
-			"huaweicloud_vpc_subnet":             vpc.DataSourceVpcSubnetV1(),
-			"huaweicloud_vpc_subnets":            vpc.DataSourceVpcSubnets(),
-			"huaweicloud_vpc_subnet_ids":         vpc.DataSourceVpcSubnetIdsV1(),
+			"huaweicloud_vpc":                       vpc.DataSourceVpcV1(),
+			"huaweicloud_vpcs":                      vpc.DataSourceVpcs(),
+			"huaweicloud_vpc_ids":                   vpc.DataSourceVpcIdsV1(),
+			"huaweicloud_vpc_peering_connection":    vpc.DataSourceVpcPeeringConnectionV2(),
+			"huaweicloud_vpc_reachability_analysis": vpc.DataSourceVpcReachabilityAnalysis(),
+			"huaweicloud_vpc_route_table":           vpc.DataSourceVPCRouteTable(),
+			"huaweicloud_vpc_subnet":                vpc.DataSourceVpcSubnetV1(),
+			"huaweicloud_vpc_subnets":               vpc.DataSourceVpcSubnets(),
+			"huaweicloud_vpc_subnet_ids":            vpc.DataSourceVpcSubnetIdsV1(),
 
 			"huaweicloud_vpcep_endpoints":           vpcep.DataSourceVPCEPEndpoints(),
 			"huaweicloud_vpcep_public_services":     vpcep.DataSourceVPCEPPublicServices(),
@@ -679,6 +683,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_vpn_customer_gateways":          vpn.DataSourceVpnCustomerGateways(),
 			"huaweicloud_vpn_connections":                vpn.DataSourceVpnConnections(),
 			"huaweicloud_vpn_connection_health_checks":   vpn.DataSourceVpnConnectionHealthChecks(),
+			"huaweicloud_vpn_p2c_client_config":          vpn.DataSourceP2CClientConfig(),
 
 			"huaweicloud_waf_certificate":         waf.DataSourceWafCertificateV1(),
 			"huaweicloud_waf_policies":            waf.DataSourceWafPoliciesV1(),
@@ -818,6 +823,8 @@ func Provider() *schema.Provider {
 			"huaweicloud_as_bandwidth_policy": as.ResourceASBandWidthPolicy(),
 			"huaweicloud_as_planned_task":     as.ResourcePlannedTask(),
 
+			"huaweicloud_as_target_tracking_policy": as.ResourceASTargetTrackingPolicy(),
+
 			"huaweicloud_bms_instance": bms.ResourceBmsInstance(),
 			"huaweicloud_bcs_instance": bcs.ResourceInstance(),
 
@@ -839,15 +846,24 @@ func Provider() *schema.Provider {
 			"huaweicloud_cc_central_network_policy_apply": cc.ResourceCentralNetworkPolicyApply(),
 			"huaweicloud_cc_central_network_attachment":   cc.ResourceCentralNetworkAttachment(),
 
-			"huaweicloud_cce_cluster":     cce.ResourceCluster(),
-			"huaweicloud_cce_node":        cce.ResourceNode(),
-			"huaweicloud_cce_node_attach": cce.ResourceNodeAttach(),
-			"huaweicloud_cce_addon":       cce.ResourceAddon(),
-			"huaweicloud_cce_node_pool":   cce.ResourceNodePool(),
-			"huaweicloud_cce_namespace":   cce.ResourceCCENamespaceV1(),
-			"huaweicloud_cce_pvc":         cce.ResourceCcePersistentVolumeClaimsV1(),
-			"huaweicloud_cce_partition":   cce.ResourcePartition(),
-			"huaweicloud_cce_chart":       cce.ResourceChart(),
+			"huaweicloud_cce_cluster":                    cce.ResourceCluster(),
+			"huaweicloud_cce_node":                       cce.ResourceNode(),
+			"huaweicloud_cce_node_attach":                cce.ResourceNodeAttach(),
+			"huaweicloud_cce_addon":                      cce.ResourceAddon(),
+			"huaweicloud_cce_node_pool":                  cce.ResourceNodePool(),
+			"huaweicloud_cce_namespace":                  cce.ResourceCCENamespaceV1(),
+			"huaweicloud_cce_pvc":                        cce.ResourceCcePersistentVolumeClaimsV1(),
+			"huaweicloud_cce_partition":                  cce.ResourcePartition(),
+			"huaweicloud_cce_chart":                      cce.ResourceChart(),
+			"huaweicloud_cce_manifest":                   cce.ResourceManifest(),
+			"huaweicloud_cce_release":                    cce.ResourceRelease(),
+			"huaweicloud_cce_node_pool_scale_policy":     cce.ResourceNodePoolScalePolicy(),
+			"huaweicloud_cce_autoscaler_config":          cce.ResourceAutoscalerConfig(),
+			"huaweicloud_cce_cluster_certificate_revoke": cce.ResourceClusterCertificateRevoke(),
+			"huaweicloud_cce_hibernation_schedule":       cce.ResourceHibernationSchedule(),
+			"huaweicloud_cce_workload_identity_binding":  cce.ResourceWorkloadIdentityBinding(),
+			"huaweicloud_cce_backup_plan":                cce.ResourceBackupPlan(),
+			"huaweicloud_cce_restore_job":                cce.ResourceRestoreJob(),
 
 			"huaweicloud_cts_tracker":      cts.ResourceCTSTracker(),
 			"huaweicloud_cts_data_tracker": cts.ResourceCTSDataTracker(),
@@ -982,6 +998,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_dns_resolver_rule":           dns.ResourceDNSResolverRule(),
 			"huaweicloud_dns_resolver_rule_associate": dns.ResourceDNSResolverRuleAssociate(),
 			"huaweicloud_dns_line_group":              dns.ResourceDNSLineGroup(),
+			"huaweicloud_dns_zone_file":               dns.ResourceZoneFile(),
 
 			"huaweicloud_drs_job": drs.ResourceDrsJob(),
 
@@ -1003,6 +1020,7 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_elb_certificate":         elb.ResourceCertificateV3(),
 			"huaweicloud_elb_l7policy":            elb.ResourceL7PolicyV3(),
+			"huaweicloud_elb_forwarding_policy":   elb.ResourceForwardingPolicy(),
 			"huaweicloud_elb_l7rule":              elb.ResourceL7RuleV3(),
 			"huaweicloud_elb_listener":            elb.ResourceListenerV3(),
 			"huaweicloud_elb_loadbalancer":        elb.ResourceLoadBalancerV3(),
@@ -1011,21 +1029,26 @@ func Provider() *schema.Provider {
 			"huaweicloud_elb_pool":                elb.ResourcePoolV3(),
 			"huaweicloud_elb_active_standby_pool": elb.ResourceActiveStandbyPool(),
 			"huaweicloud_elb_member":              elb.ResourceMemberV3(),
+			"huaweicloud_elb_members":             elb.ResourceMembers(),
 			"huaweicloud_elb_logtank":             elb.ResourceLogTank(),
 			"huaweicloud_elb_security_policy":     elb.ResourceSecurityPolicy(),
 
 			"huaweicloud_enterprise_project": eps.ResourceEnterpriseProject(),
 
-			"huaweicloud_er_association":    er.ResourceAssociation(),
-			"huaweicloud_er_instance":       er.ResourceInstance(),
-			"huaweicloud_er_propagation":    er.ResourcePropagation(),
-			"huaweicloud_er_route_table":    er.ResourceRouteTable(),
-			"huaweicloud_er_static_route":   er.ResourceStaticRoute(),
-			"huaweicloud_er_vpc_attachment": er.ResourceVpcAttachment(),
-			"huaweicloud_er_flow_log":       er.ResourceFlowLog(),
-
-			"huaweicloud_evs_snapshot": evs.ResourceEvsSnapshotV2(),
-			"huaweicloud_evs_volume":   evs.ResourceEvsVolume(),
+			"huaweicloud_er_association":        er.ResourceAssociation(),
+			"huaweicloud_er_instance":           er.ResourceInstance(),
+			"huaweicloud_er_propagation":        er.ResourcePropagation(),
+			"huaweicloud_er_route_table":        er.ResourceRouteTable(),
+			"huaweicloud_er_static_route":       er.ResourceStaticRoute(),
+			"huaweicloud_er_vpc_attachment":     er.ResourceVpcAttachment(),
+			"huaweicloud_er_flow_log":           er.ResourceFlowLog(),
+			"huaweicloud_er_vpn_attachment":     er.ResourceVpnAttachment(),
+			"huaweicloud_er_dc_attachment":      er.ResourceDcAttachment(),
+			"huaweicloud_er_peering_attachment": er.ResourcePeeringAttachment(),
+
+			"huaweicloud_evs_snapshot":          evs.ResourceEvsSnapshotV2(),
+			"huaweicloud_evs_snapshot_rollback": evs.ResourceEvsSnapshotRollback(),
+			"huaweicloud_evs_volume":            evs.ResourceEvsVolume(),
 
 			"huaweicloud_fgs_application":                fgs.ResourceApplication(),
 			"huaweicloud_fgs_async_invoke_configuration": fgs.ResourceAsyncInvokeConfiguration(),
@@ -1203,21 +1226,24 @@ func Provider() *schema.Provider {
 			"huaweicloud_mrs_cluster": ResourceMRSClusterV1(),
 			"huaweicloud_mrs_job":     ResourceMRSJobV1(),
 
-			"huaweicloud_nat_dnat_rule": nat.ResourcePublicDnatRule(),
-			"huaweicloud_nat_gateway":   nat.ResourcePublicGateway(),
-			"huaweicloud_nat_snat_rule": nat.ResourcePublicSnatRule(),
+			"huaweicloud_nat_dnat_rule":  nat.ResourcePublicDnatRule(),
+			"huaweicloud_nat_dnat_rules": nat.ResourcePublicDnatRules(),
+			"huaweicloud_nat_gateway":    nat.ResourcePublicGateway(),
+			"huaweicloud_nat_snat_rule":  nat.ResourcePublicSnatRule(),
+			"huaweicloud_nat_snat_rules": nat.ResourcePublicSnatRules(),
 
 			"huaweicloud_nat_private_dnat_rule":  nat.ResourcePrivateDnatRule(),
 			"huaweicloud_nat_private_gateway":    nat.ResourcePrivateGateway(),
 			"huaweicloud_nat_private_snat_rule":  nat.ResourcePrivateSnatRule(),
 			"huaweicloud_nat_private_transit_ip": nat.ResourcePrivateTransitIp(),
 
-			"huaweicloud_network_acl":              ResourceNetworkACL(),
-			"huaweicloud_network_acl_rule":         ResourceNetworkACLRule(),
-			"huaweicloud_networking_secgroup":      vpc.ResourceNetworkingSecGroup(),
-			"huaweicloud_networking_secgroup_rule": vpc.ResourceNetworkingSecGroupRule(),
-			"huaweicloud_networking_vip":           vpc.ResourceNetworkingVip(),
-			"huaweicloud_networking_vip_associate": vpc.ResourceNetworkingVIPAssociateV2(),
+			"huaweicloud_network_acl":               ResourceNetworkACL(),
+			"huaweicloud_network_acl_rule":          ResourceNetworkACLRule(),
+			"huaweicloud_networking_secgroup":       vpc.ResourceNetworkingSecGroup(),
+			"huaweicloud_networking_secgroup_rule":  vpc.ResourceNetworkingSecGroupRule(),
+			"huaweicloud_networking_secgroup_rules": vpc.ResourceNetworkingSecGroupRules(),
+			"huaweicloud_networking_vip":            vpc.ResourceNetworkingVip(),
+			"huaweicloud_networking_vip_associate":  vpc.ResourceNetworkingVIPAssociateV2(),
 
 			"huaweicloud_obs_bucket":             obs.ResourceObsBucket(),
 			"huaweicloud_obs_bucket_acl":         obs.ResourceOBSBucketAcl(),
@@ -1347,6 +1373,9 @@ func Provider() *schema.Provider {
 			"huaweicloud_vpn_customer_gateway":        vpn.ResourceCustomerGateway(),
 			"huaweicloud_vpn_connection":              vpn.ResourceConnection(),
 			"huaweicloud_vpn_connection_health_check": vpn.ResourceConnectionHealthCheck(),
+			"huaweicloud_vpn_p2c_gateway":             vpn.ResourceP2CGateway(),
+			"huaweicloud_vpn_server":                  vpn.ResourceServer(),
+			"huaweicloud_vpn_user":                    vpn.ResourceUser(),
 
 			"huaweicloud_waf_address_group":                       waf.ResourceWafAddressGroup(),
 			"huaweicloud_waf_certificate":                         waf.ResourceWafCertificateV1(),
@@ -138,6 +138,13 @@ var (
 	HW_IDENTITY_CENTER_ACCOUNT_ID = os.Getenv("HW_IDENTITY_CENTER_ACCOUNT_ID")
 
 	HW_ER_TEST_ON = os.Getenv("HW_ER_TEST_ON") // Whether to run the ER related tests.
+	// The ER instance and the resources whose attachments are created by other services (DC and CC).
+	HW_ER_INSTANCE_ID           = os.Getenv("HW_ER_INSTANCE_ID")
+	HW_ER_DC_VIRTUAL_GATEWAY_ID = os.Getenv("HW_ER_DC_VIRTUAL_GATEWAY_ID")
+	HW_ER_PEERING_CONNECTION_ID = os.Getenv("HW_ER_PEERING_CONNECTION_ID")
+
+	// The ID of the server certificate used by the VPN server of the P2C VPN gateway.
+	HW_VPN_P2C_SERVER_CERTIFICATE_ID = os.Getenv("HW_VPN_P2C_SERVER_CERTIFICATE_ID")
 
 	// The OBS address where the HCL/JSON template archive (No variables) is located.
 	HW_RF_TEMPLATE_ARCHIVE_NO_VARS_URI = os.Getenv("HW_RF_TEMPLATE_ARCHIVE_NO_VARS_URI")
@@ -167,6 +174,8 @@ var (
 	HW_CCE_CLUSTER_NAME_ANOTHER = os.Getenv("HW_CCE_CLUSTER_NAME_ANOTHER")
 	// The partition az of the CCE
 	HW_CCE_PARTITION_AZ = os.Getenv("HW_CCE_PARTITION_AZ")
+	// The backup name of the CCE cluster (HW_CCE_CLUSTER_ID) in which the e-backup add-on is installed
+	HW_CCE_BACKUP_NAME = os.Getenv("HW_CCE_BACKUP_NAME")
 	// The namespace of the workload is located
 	HW_WORKLOAD_NAMESPACE = os.Getenv("HW_WORKLOAD_NAMESPACE")
 	// The workload type deployed in CCE/CCI
@@ -837,6 +846,28 @@ func TestAccPreCheckER(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckERDcAttachment(t *testing.T) {
+	if HW_ER_INSTANCE_ID == "" || HW_ER_DC_VIRTUAL_GATEWAY_ID == "" {
+		t.Skip("HW_ER_INSTANCE_ID and HW_ER_DC_VIRTUAL_GATEWAY_ID must be set for ER DC attachment acceptance tests.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckERPeeringAttachment(t *testing.T) {
+	if HW_ER_INSTANCE_ID == "" || HW_ER_PEERING_CONNECTION_ID == "" {
+		t.Skip("HW_ER_INSTANCE_ID and HW_ER_PEERING_CONNECTION_ID must be set for ER peering attachment " +
+			"acceptance tests.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckVPNP2CServerCertificate(t *testing.T) {
+	if HW_VPN_P2C_SERVER_CERTIFICATE_ID == "" {
+		t.Skip("HW_VPN_P2C_SERVER_CERTIFICATE_ID must be set for VPN server acceptance tests.")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckRfArchives(t *testing.T) {
 	if HW_RF_TEMPLATE_ARCHIVE_NO_VARS_URI == "" || HW_RF_TEMPLATE_ARCHIVE_URI == "" ||
@@ -894,6 +925,13 @@ func TestAccPreCheckCceClusterId(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckCceBackupName(t *testing.T) {
+	if HW_CCE_CLUSTER_ID == "" || HW_CCE_BACKUP_NAME == "" {
+		t.Skip("HW_CCE_CLUSTER_ID and HW_CCE_BACKUP_NAME must be set for CCE restore job acceptance tests")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckCceChartPath(t *testing.T) {
 	// HW_CCE_CHART_PATH is the absolute path of the chart package
@@ -157,6 +157,41 @@ func TestAccASGroup_sourceDestCheck(t *testing.T) {
 	})
 }
 
+func TestAccASGroup_instanceRefresh(t *testing.T) {
+	var asGroup groups.Group
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_as_group.acc_as_group"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASGroup_instanceRefresh(rName, "acc_as_config"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASGroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "scaling_configuration_id",
+						"huaweicloud_as_configuration.acc_as_config", "id"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.batch_size", "1"),
+				),
+			},
+			{
+				Config: testASGroup_instanceRefresh(rName, "acc_as_config_new"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASGroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "scaling_configuration_id",
+						"huaweicloud_as_configuration.acc_as_config_new", "id"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INSERVICE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckASGroupDestroy(s *terraform.State) error {
 	conf := acceptance.TestAccProvider.Meta().(*config.Config)
 	asClient, err := conf.AutoscalingV1Client(acceptance.HW_REGION_NAME)
@@ -446,3 +481,47 @@ resource "huaweicloud_as_group" "acc_as_group"{
 }
 `, testASGroup_Base(rName), rName)
 }
+
+func testASGroup_instanceRefresh(rName, configName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_configuration" "acc_as_config_new"{
+  scaling_configuration_name = "%[2]s_new"
+  instance_config {
+    image    = data.huaweicloud_images_image.test.id
+    flavor   = data.huaweicloud_compute_flavors.test.ids[0]
+    key_name = huaweicloud_kps_keypair.acc_key.id
+    disk {
+      size        = 50
+      volume_type = "SSD"
+      disk_type   = "SYS"
+    }
+  }
+}
+
+resource "huaweicloud_as_group" "acc_as_group"{
+  scaling_group_name       = "%[2]s"
+  scaling_configuration_id = huaweicloud_as_configuration.%[3]s.id
+  vpc_id                   = huaweicloud_vpc.test.id
+  desire_instance_number   = 2
+  min_instance_number      = 0
+  max_instance_number      = 3
+  delete_publicip          = true
+  delete_instances         = "yes"
+
+  networks {
+    id = huaweicloud_vpc_subnet.test.id
+  }
+  security_groups {
+    id = huaweicloud_networking_secgroup.test.id
+  }
+
+  instance_refresh {
+    min_healthy_percentage = 50
+    batch_size             = 1
+    pause_time             = 30
+  }
+}
+`, testASGroup_Base(rName), rName, configName)
+}
@@ -0,0 +1,93 @@
+package as
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/policies"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getTargetTrackingPolicyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.AutoscalingV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating autoscaling client: %s", err)
+	}
+	return policies.Get(client, state.Primary.ID).Extract()
+}
+
+func TestAccASTargetTrackingPolicy_basic(t *testing.T) {
+	var policy policies.Policy
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_as_target_tracking_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&policy,
+		getTargetTrackingPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testASTargetTrackingPolicy_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "scaling_group_id",
+						"huaweicloud_as_group.acc_as_group", "id"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "cpu_util"),
+					resource.TestCheckResourceAttr(resourceName, "target_value", "60"),
+					resource.TestCheckResourceAttr(resourceName, "cool_down_time", "300"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_out_alarm_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_in_policy_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_in_alarm_id"),
+				),
+			},
+			{
+				Config: testASTargetTrackingPolicy_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "target_value", "70"),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_threshold", "40"),
+					resource.TestCheckResourceAttr(resourceName, "scale_out_instance_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cool_down_time", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testASTargetTrackingPolicy_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_target_tracking_policy" "test" {
+  scaling_group_id = huaweicloud_as_group.acc_as_group.id
+  name             = "%[2]s"
+  target_value     = 60
+}
+`, testASPolicy_base(rName), rName)
+}
+
+func testASTargetTrackingPolicy_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_target_tracking_policy" "test" {
+  scaling_group_id          = huaweicloud_as_group.acc_as_group.id
+  name                      = "%[2]s"
+  target_value              = 70
+  scale_in_threshold        = 40
+  scale_out_instance_number = 2
+  cool_down_time            = 600
+}
+`, testASPolicy_base(rName), rName)
+}
@@ -2,6 +2,7 @@ package cce
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,6 +32,15 @@ func TestAccClusterCertificateDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(datasourceName, "kube_config_raw"),
 				),
 			},
+			{
+				Config: testClousterCertificate_exec(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(datasourceName, "exec_kube_config_raw"),
+					resource.TestMatchResourceAttr(datasourceName, "exec_kube_config_raw",
+						regexp.MustCompile(`"command":"cce-token"`)),
+				),
+			},
 		},
 	})
 }
@@ -44,3 +54,18 @@ data "huaweicloud_cce_cluster_certificate" "test" {
   duration   = 30
 }`, testAccCluster_basic(name))
 }
+
+func testClousterCertificate_exec(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_cce_cluster_certificate" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+  duration   = 1
+
+  exec_credential {
+    command = "cce-token"
+    args    = ["--cluster-id", huaweicloud_cce_cluster.test.id]
+  }
+}`, testAccCluster_basic(name))
+}
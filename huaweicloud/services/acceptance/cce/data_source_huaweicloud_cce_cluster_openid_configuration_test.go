@@ -0,0 +1,45 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccClusterOpenIDConfigurationDataSource_basic(t *testing.T) {
+	var (
+		name           = acceptance.RandomAccResourceNameWithDash()
+		datasourceName = "data.huaweicloud_cce_cluster_openid_configuration.test"
+		dc             = acceptance.InitDataSourceCheck(datasourceName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterOpenIDConfigurationDataSource_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(datasourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(datasourceName, "jwks_uri"),
+					resource.TestCheckResourceAttrSet(datasourceName, "signing_key"),
+					resource.TestCheckResourceAttrSet(datasourceName, "id_token_signing_alg_values_supported.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterOpenIDConfigurationDataSource_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_cce_cluster_openid_configuration" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+}
+`, testAccCluster_basic(name))
+}
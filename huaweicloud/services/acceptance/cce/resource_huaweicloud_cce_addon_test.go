@@ -2,6 +2,7 @@ package cce
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -254,3 +255,78 @@ resource "huaweicloud_cce_addon" "test" {
 }
 `, testAccAddon_values_base(rName), acceptance.HW_PROJECT_ID)
 }
+
+func TestAccAddon_typedValues(t *testing.T) {
+	var (
+		addon addons.Addon
+
+		name         = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "huaweicloud_cce_addon.test"
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&addon,
+			getAddonFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckProjectID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAddon_typedValues(name, "invalid"),
+				ExpectError: regexp.MustCompile("the add-on values are invalid for the template"),
+			},
+			{
+				Config: testAccAddon_typedValues(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "autoscaler"),
+					resource.TestCheckResourceAttr(resourceName, "flattened_values.custom.logLevel", "3"),
+					resource.TestCheckResourceAttr(resourceName, "flattened_values.custom.scaleDownEnabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "flattened_values.custom.cluster_id",
+						"huaweicloud_cce_cluster.test", "id"),
+				),
+			},
+			{
+				Config: testAccAddon_typedValues(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flattened_values.custom.scaleDownEnabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddon_typedValues(name, scaleDownEnabled string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_addon" "test" {
+  depends_on = [
+    huaweicloud_cce_node_pool.test,
+  ]
+
+  cluster_id              = huaweicloud_cce_cluster.test.id
+  template_name           = "autoscaler"
+  version                 = "1.25.21"
+  merge_template_defaults = true
+
+  values {
+    basic_json = jsonencode({})
+    custom = {
+      "cluster_id"       = huaweicloud_cce_cluster.test.id
+      "tenant_id"        = "%[2]s"
+      "logLevel"         = 3
+      "scaleDownEnabled" = "%[3]s"
+    }
+  }
+}
+`, testAccAddon_values_base(name), acceptance.HW_PROJECT_ID, scaleDownEnabled)
+}
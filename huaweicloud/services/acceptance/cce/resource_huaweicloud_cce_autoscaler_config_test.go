@@ -0,0 +1,86 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccAutoscalerConfig_basic(t *testing.T) {
+	var (
+		addon addons.Addon
+
+		name         = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "huaweicloud_cce_autoscaler_config.test"
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&addon,
+			getAddonFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoscalerConfig_basic(name, false, 10),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id",
+						"huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.25.21"),
+					resource.TestCheckResourceAttr(resourceName, "expander", "priority"),
+					resource.TestCheckResourceAttr(resourceName, "scale_down_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scale_down_delay_after_add", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_up_unscheduled_pod_enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccAutoscalerConfig_basic(name, true, 20),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "scale_down_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scale_down_utilization_threshold", "0.4"),
+					resource.TestCheckResourceAttr(resourceName, "scale_down_delay_after_add", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAddonImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccAutoscalerConfig_basic(name string, scaleDownEnabled bool, delayAfterAdd int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_autoscaler_config" "test" {
+  depends_on = [
+    huaweicloud_cce_node_pool.test,
+  ]
+
+  cluster_id                       = huaweicloud_cce_cluster.test.id
+  version                          = "1.25.21"
+  expander                         = "priority"
+  scale_down_enabled               = %[2]t
+  scale_down_utilization_threshold = 0.4
+  scale_down_delay_after_add       = %[3]d
+  scale_down_delay_after_delete    = 10
+}
+`, testAccAddon_values_base(name), scaleDownEnabled, delayAfterAdd)
+}
@@ -0,0 +1,165 @@
+package cce
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getVeleroResourceFunc(resourceType string) func(*config.Config, *terraform.ResourceState) (interface{}, error) {
+	return func(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+		client, err := cfg.CceV1Client(acceptance.HW_REGION_NAME)
+		if err != nil {
+			return nil, fmt.Errorf("error creating CCE v1 client: %s", err)
+		}
+
+		u, err := url.Parse(client.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		u.Host = state.Primary.Attributes["cluster_id"] + "." + u.Host
+		getPath := u.String() + "apis/velero.io/v1/namespaces/velero/" + resourceType + "/" + state.Primary.ID
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		}
+		getResp, err := client.Request("GET", getPath, &getOpt)
+		if err != nil {
+			return nil, err
+		}
+		return utils.FlattenResponse(getResp)
+	}
+}
+
+func TestAccBackupPlan_basic(t *testing.T) {
+	var (
+		plan         interface{}
+		resourceName = "huaweicloud_cce_backup_plan.test"
+		name         = acceptance.RandomAccResourceNameWithDash()
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&plan,
+			getVeleroResourceFunc("schedules"),
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			// The e-backup add-on must be installed in the cluster.
+			acceptance.TestAccPreCheckCceClusterId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupPlan_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", acceptance.HW_CCE_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "0 2 * * *"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_location.0.bucket",
+						"huaweicloud_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.prefix", "backup"),
+					resource.TestCheckResourceAttr(resourceName, "included_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "label_selector.app", "demo"),
+					resource.TestCheckResourceAttr(resourceName, "backup_volumes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_hours", "720"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccBackupPlan_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "0 */6 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.0.prefix", "backup-updated"),
+					resource.TestCheckResourceAttr(resourceName, "included_namespaces.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "label_selector.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "backup_volumes", "false"),
+					resource.TestCheckResourceAttr(resourceName, "retention_hours", "168"),
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccBackupPlanImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccBackupPlanImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccBackupPlan_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+}
+`, name)
+}
+
+func testAccBackupPlan_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_backup_plan" "test" {
+  cluster_id          = "%[2]s"
+  name                = "%[3]s"
+  schedule            = "0 2 * * *"
+  included_namespaces = ["default"]
+
+  label_selector = {
+    app = "demo"
+  }
+
+  storage_location {
+    bucket = huaweicloud_obs_bucket.test.bucket
+    prefix = "backup"
+  }
+}
+`, testAccBackupPlan_base(name), acceptance.HW_CCE_CLUSTER_ID, name)
+}
+
+func testAccBackupPlan_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_backup_plan" "test" {
+  cluster_id          = "%[2]s"
+  name                = "%[3]s"
+  schedule            = "0 */6 * * *"
+  included_namespaces = ["default", "kube-public"]
+  backup_volumes      = false
+  retention_hours     = 168
+  paused              = true
+
+  storage_location {
+    bucket = huaweicloud_obs_bucket.test.bucket
+    prefix = "backup-updated"
+  }
+}
+`, testAccBackupPlan_base(name), acceptance.HW_CCE_CLUSTER_ID, name)
+}
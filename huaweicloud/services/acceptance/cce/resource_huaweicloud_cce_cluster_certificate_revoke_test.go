@@ -0,0 +1,58 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccClusterCertificateRevoke_basic(t *testing.T) {
+	var (
+		name         = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "huaweicloud_cce_cluster_certificate_revoke.test"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckUserId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCertificateRevoke_basic(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id",
+						"huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "user_id", acceptance.HW_USER_ID),
+					resource.TestCheckResourceAttr(resourceName, "triggers.round", "first"),
+				),
+			},
+			{
+				Config: testAccClusterCertificateRevoke_basic(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.round", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterCertificateRevoke_basic(name, round string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_cluster_certificate_revoke" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+  user_id    = "%[2]s"
+
+  triggers = {
+    round = "%[3]s"
+  }
+}
+`, testAccCluster_basic(name), acceptance.HW_USER_ID, round)
+}
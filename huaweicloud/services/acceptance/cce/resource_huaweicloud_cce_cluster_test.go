@@ -236,6 +236,36 @@ func TestAccCluster_hibernate(t *testing.T) {
 	})
 }
 
+func TestAccCluster_upgrade(t *testing.T) {
+	var cluster clusters.Clusters
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCluster_upgrade(rName, "v1.23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_version", "v1.23"),
+				),
+			},
+			{
+				Config: testAccCluster_upgrade(rName, "v1.25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_version", "v1.25"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCluster_multiContainerNetworkCidrs(t *testing.T) {
 	var cluster clusters.Clusters
 
@@ -585,6 +615,30 @@ resource "huaweicloud_cce_cluster" "test" {
 `, common.TestVpc(rName), rName)
 }
 
+func testAccCluster_upgrade(rName, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_cluster" "test" {
+  name                   = "%s"
+  flavor_id              = "cce.s1.small"
+  cluster_version        = "%s"
+  vpc_id                 = huaweicloud_vpc.test.id
+  subnet_id              = huaweicloud_vpc_subnet.test.id
+  container_network_type = "overlay_l2"
+  service_network_cidr   = "10.248.0.0/16"
+
+  upgrade_options {
+    snapshot = true
+  }
+
+  timeouts {
+    update = "180m"
+  }
+}
+`, common.TestVpc(rName), rName, version)
+}
+
 func testAccCluster_multiContainerNetworkCidrs(rName, containerNetworkCidr string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,116 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getHibernationScheduleFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.FgsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v2/{project_id}/fgs/functions/{function_urn}/config"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{function_urn}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccHibernationSchedule_basic(t *testing.T) {
+	var (
+		schedule     interface{}
+		resourceName = "huaweicloud_cce_hibernation_schedule.test"
+		name         = acceptance.RandomAccResourceNameWithDash()
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&schedule,
+			getHibernationScheduleFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckFgsAgency(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHibernationSchedule_basic(name, "0 20 * * 1-5", "UTC", true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id",
+						"huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "agency_name", acceptance.HW_FGS_AGENCY_NAME),
+					resource.TestCheckResourceAttr(resourceName, "hibernate_schedule", "0 20 * * 1-5"),
+					resource.TestCheckResourceAttr(resourceName, "wakeup_schedule", "0 8 * * 1-5"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "node_pools.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "node_pools.0.node_pool_id",
+						"huaweicloud_cce_node_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "node_pools.0.node_count", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_urn"),
+					resource.TestCheckResourceAttrSet(resourceName, "trigger_id"),
+				),
+			},
+			{
+				Config: testAccHibernationSchedule_basic(name, "30 21 * * *", "Asia/Shanghai", false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "hibernate_schedule", "30 21 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "Asia/Shanghai"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccHibernationSchedule_basic(name, hibernateSchedule, timeZone string, enabled bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_hibernation_schedule" "test" {
+  cluster_id         = huaweicloud_cce_cluster.test.id
+  name               = "%[2]s"
+  agency_name        = "%[3]s"
+  hibernate_schedule = "%[4]s"
+  wakeup_schedule    = "0 8 * * 1-5"
+  time_zone          = "%[5]s"
+  enabled            = %[6]t
+
+  node_pools {
+    node_pool_id = huaweicloud_cce_node_pool.test.id
+    node_count   = 1
+  }
+}
+`, testAccNodePool_basic_step1(name, testAccNodePool_base(name)), name, acceptance.HW_FGS_AGENCY_NAME,
+		hibernateSchedule, timeZone, enabled)
+}
@@ -0,0 +1,138 @@
+package cce
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getManifestResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.CceV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	u, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Host = state.Primary.Attributes["cluster_id"] + "." + u.Host
+	getPath := fmt.Sprintf("%sapi/v1/namespaces/%s/configmaps/%s", u.String(),
+		state.Primary.Attributes["namespace"], state.Primary.Attributes["name"])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	if utils.PathSearch("metadata.uid", getRespBody, "").(string) != state.Primary.ID {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return getRespBody, nil
+}
+
+func TestAccManifest_basic(t *testing.T) {
+	var (
+		obj          interface{}
+		resourceName = "huaweicloud_cce_manifest.test"
+		name         = acceptance.RandomAccResourceNameWithDash()
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&obj,
+			getManifestResourceFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManifest_basic(name, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id",
+						"huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "api_version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "ConfigMap"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "field_manager", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_propagation", "Foreground"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_version"),
+				),
+			},
+			{
+				Config: testAccManifest_basic(name, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccManifestImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"manifest", "deletion_propagation",
+				},
+			},
+		},
+	})
+}
+
+func testAccManifestImportStateIdFunc(resName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return "", fmt.Errorf("the resource (%s) of CCE manifest is not found in the tfstate", resName)
+		}
+		attrs := rs.Primary.Attributes
+		if attrs["cluster_id"] == "" || attrs["name"] == "" {
+			return "", fmt.Errorf("the name of the object or the related CCE cluster ID is missing")
+		}
+		return fmt.Sprintf("%s/%s/%s/%s/%s", attrs["cluster_id"], attrs["api_version"], attrs["kind"],
+			attrs["namespace"], attrs["name"]), nil
+	}
+}
+
+func testAccManifest_basic(name, value string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_manifest" "test" {
+  cluster_id           = huaweicloud_cce_cluster.test.id
+  deletion_propagation = "Foreground"
+
+  manifest = <<EOT
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %[2]s
+  namespace: default
+data:
+  key: %[3]s
+EOT
+}
+`, testAccCceCluster_config(name), name, value)
+}
@@ -0,0 +1,197 @@
+package cce
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getNodePoolScalePolicyFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CceV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	u, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Host = state.Primary.Attributes["cluster_id"] + "." + u.Host
+	getPath := u.String() + "apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers/" +
+		state.Primary.ID
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccNodePoolScalePolicy_basic(t *testing.T) {
+	var (
+		policy interface{}
+
+		name         = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "huaweicloud_cce_node_pool_scale_policy.test"
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&policy,
+			getNodePoolScalePolicyFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodePoolScalePolicy_basic_step1(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "node_pool_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.type", "Metric"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.metric_trigger.0.metric_name", "Cpu"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.metric_trigger.0.value", "80"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action.0.value", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccNodePoolScalePolicy_basic_step2(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.metric_trigger.0.metric_name", "Memory"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.type", "Cron"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.cron_trigger.0.schedule", "0 8 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action.0.unit", "Percent"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNodePoolScalePolicyImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccNodePoolScalePolicyImportStateIdFunc(resName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccNodePoolScalePolicy_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_autoscaler_config" "test" {
+  depends_on = [
+    huaweicloud_cce_node_pool.test,
+  ]
+
+  cluster_id = huaweicloud_cce_cluster.test.id
+  version    = "1.25.21"
+}
+`, testAccAddon_values_base(name))
+}
+
+func testAccNodePoolScalePolicy_basic_step1(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_node_pool_scale_policy" "test" {
+  depends_on = [
+    huaweicloud_cce_autoscaler_config.test,
+  ]
+
+  cluster_id    = huaweicloud_cce_cluster.test.id
+  name          = "%[2]s"
+  node_pool_ids = [huaweicloud_cce_node_pool.test.id]
+
+  rules {
+    name = "cpu-rule"
+    type = "Metric"
+
+    metric_trigger {
+      metric_name = "Cpu"
+      value       = 80
+    }
+
+    action {
+      value = 1
+    }
+  }
+}
+`, testAccNodePoolScalePolicy_base(name), name)
+}
+
+func testAccNodePoolScalePolicy_basic_step2(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_node_pool_scale_policy" "test" {
+  depends_on = [
+    huaweicloud_cce_autoscaler_config.test,
+  ]
+
+  cluster_id    = huaweicloud_cce_cluster.test.id
+  name          = "%[2]s"
+  node_pool_ids = [huaweicloud_cce_node_pool.test.id]
+  enabled       = false
+
+  rules {
+    name = "memory-rule"
+    type = "Metric"
+
+    metric_trigger {
+      metric_name = "Memory"
+      value       = 70
+    }
+
+    action {
+      value = 2
+    }
+  }
+
+  rules {
+    name = "cron-rule"
+    type = "Cron"
+
+    cron_trigger {
+      schedule = "0 8 * * *"
+    }
+
+    action {
+      unit  = "Percent"
+      value = 10
+    }
+  }
+}
+`, testAccNodePoolScalePolicy_base(name), name)
+}
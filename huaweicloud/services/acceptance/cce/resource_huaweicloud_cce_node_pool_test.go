@@ -431,6 +431,83 @@ resource "huaweicloud_cce_node_pool" "test" {
 `, testAccNodePool_base(name), name)
 }
 
+func TestAccNodePool_rollingUpdate(t *testing.T) {
+	var (
+		nodePool nodepools.NodePool
+
+		name         = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "huaweicloud_cce_node_pool.test"
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&nodePool,
+			getNodePoolFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodePool_rollingUpdate(name, "EulerOS 2.9", "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "os", "EulerOS 2.9"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+				),
+			},
+			{
+				Config: testAccNodePool_rollingUpdate(name, "CentOS 7.6", "echo world"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &nodePool.Metadata.Id),
+					resource.TestCheckResourceAttr(resourceName, "os", "CentOS 7.6"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodePool_rollingUpdate(name, os, script string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_node_pool" "test" {
+  cluster_id         = huaweicloud_cce_cluster.test.id
+  name               = "%[2]s"
+  os                 = "%[3]s"
+  flavor_id          = data.huaweicloud_compute_flavors.test.ids[0]
+  initial_node_count = 2
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+  key_pair           = huaweicloud_kps_keypair.test.name
+  type               = "vm"
+
+  root_volume {
+    size       = 40
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+  }
+
+  extend_params {
+    postinstall = "%[4]s"
+  }
+
+  rolling_update {
+    max_unavailable = 1
+    drain_timeout   = 120
+  }
+}
+`, testAccNodePool_base(name), name, os, script)
+}
+
 func TestAccNodePool_volume_encryption(t *testing.T) {
 	var (
 		nodePool nodepools.NodePool
@@ -0,0 +1,163 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getReleaseFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CceV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "cce/cam/v3/clusters/{cluster_id}/namespace/{namespace}/releases/{name}"
+	getPath = strings.ReplaceAll(getPath, "{cluster_id}", state.Primary.Attributes["cluster_id"])
+	getPath = strings.ReplaceAll(getPath, "{namespace}", state.Primary.Attributes["namespace"])
+	getPath = strings.ReplaceAll(getPath, "{name}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccRelease_basic(t *testing.T) {
+	var (
+		release      interface{}
+		resourceName = "huaweicloud_cce_release.test"
+		name         = acceptance.RandomAccResourceNameWithDash()
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&release,
+			getReleaseFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckCceChartPath(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRelease_basic(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttrPair(resourceName, "chart_id",
+						"huaweicloud_cce_chart.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DEPLOYED"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "chart_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "chart_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccRelease_basic(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "DEPLOYED"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+			},
+			{
+				Config: testAccRelease_rollback(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "DEPLOYED"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccReleaseImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"chart_id", "values", "set_values", "atomic", "rollback_revision",
+				},
+			},
+		},
+	})
+}
+
+func testAccReleaseImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["namespace"],
+			rs.Primary.ID), nil
+	}
+}
+
+func testAccRelease_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_chart" "test" {
+  content    = "%[2]s"
+  parameters = "{\"override\":true,\"skip_lint\":true,\"source\":\"package\"}"
+}
+`, testAccCceCluster_config(name), acceptance.HW_CCE_CHART_PATH)
+}
+
+func testAccRelease_basic(name string, replicas int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_release" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+  name       = "%[2]s"
+  chart_id   = huaweicloud_cce_chart.test.id
+  atomic     = true
+
+  values = <<EOT
+replicaCount: %[3]d
+EOT
+
+  set_values = {
+    "image.pullPolicy" = "IfNotPresent"
+  }
+}
+`, testAccRelease_base(name), name, replicas)
+}
+
+func testAccRelease_rollback(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_release" "test" {
+  cluster_id        = huaweicloud_cce_cluster.test.id
+  name              = "%[2]s"
+  chart_id          = huaweicloud_cce_chart.test.id
+  atomic            = true
+  rollback_revision = 1
+
+  values = <<EOT
+replicaCount: 2
+EOT
+
+  set_values = {
+    "image.pullPolicy" = "IfNotPresent"
+  }
+}
+`, testAccRelease_base(name), name)
+}
@@ -0,0 +1,62 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRestoreJob_basic(t *testing.T) {
+	var (
+		restore      interface{}
+		resourceName = "huaweicloud_cce_restore_job.test"
+		name         = acceptance.RandomAccResourceNameWithDash()
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&restore,
+			getVeleroResourceFunc("restores"),
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCceBackupName(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreJob_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "backup_name", acceptance.HW_CCE_BACKUP_NAME),
+					resource.TestCheckResourceAttr(resourceName, "phase", "Completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_items"),
+					resource.TestCheckResourceAttrSet(resourceName, "items_restored"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRestoreJob_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_cce_restore_job" "test" {
+  cluster_id               = "%[1]s"
+  name                     = "%[2]s"
+  backup_name              = "%[3]s"
+  existing_resource_policy = "update"
+
+  namespace_mapping = {
+    default = "%[2]s"
+  }
+}
+`, acceptance.HW_CCE_CLUSTER_ID, name, acceptance.HW_CCE_BACKUP_NAME)
+}
@@ -0,0 +1,137 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/identity/v3.0/policies"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getWorkloadIdentityBindingFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.IAMV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating IAM client: %s", err)
+	}
+	return policies.Get(client, state.Primary.Attributes["policy_id"]).Extract()
+}
+
+func TestAccWorkloadIdentityBinding_basic(t *testing.T) {
+	var (
+		policy       policies.Role
+		name         = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "huaweicloud_cce_workload_identity_binding.test"
+
+		rc = acceptance.InitResourceCheck(
+			resourceName,
+			&policy,
+			getWorkloadIdentityBindingFunc,
+		)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+			acceptance.TestAccPrecheckDomainName(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadIdentityBinding_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "provider_id",
+						"huaweicloud_identity_provider.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "service_account", "obs-reader"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "system:serviceaccount:default:obs-reader"),
+					resource.TestCheckResourceAttr(resourceName, "username", "default-obs-reader"),
+					resource.TestCheckResourceAttr(resourceName, "group_name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWorkloadIdentityBindingImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"agency_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccWorkloadIdentityBindingImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.ID, rs.Primary.Attributes["group_id"],
+			rs.Primary.Attributes["policy_id"]), nil
+	}
+}
+
+func testAccWorkloadIdentityBinding_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_cce_cluster_openid_configuration" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+}
+
+resource "huaweicloud_identity_provider" "test" {
+  name     = "%[2]s"
+  protocol = "oidc"
+
+  access_config {
+    access_type  = "program"
+    provider_url = data.huaweicloud_cce_cluster_openid_configuration.test.issuer
+    client_id    = "ias-token-exchange-service"
+    signing_key  = data.huaweicloud_cce_cluster_openid_configuration.test.signing_key
+  }
+}
+
+resource "huaweicloud_identity_agency" "test" {
+  name                  = "%[2]s"
+  delegated_domain_name = "%[3]s"
+  domain_roles          = ["OBS ReadOnlyAccess"]
+}
+
+resource "huaweicloud_cce_workload_identity_binding" "test" {
+  provider_id     = huaweicloud_identity_provider.test.id
+  namespace       = "default"
+  service_account = "obs-reader"
+  agency_id       = huaweicloud_identity_agency.test.id
+  group_name      = "%[2]s"
+  policy_name     = "%[2]s"
+}
+
+resource "huaweicloud_identity_provider_conversion" "test" {
+  provider_id = huaweicloud_identity_provider.test.id
+
+  conversion_rules {
+    local {
+      username = huaweicloud_cce_workload_identity_binding.test.username
+      group    = huaweicloud_cce_workload_identity_binding.test.group_name
+    }
+    remote {
+      attribute = "sub"
+      condition = "any_one_of"
+      value     = [huaweicloud_cce_workload_identity_binding.test.subject]
+    }
+  }
+}
+`, testAccCluster_basic(name), name, acceptance.HW_DOMAIN_NAME)
+}
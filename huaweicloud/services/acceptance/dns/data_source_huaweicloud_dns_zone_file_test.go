@@ -0,0 +1,59 @@
+package dns
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceDNSZoneFile_basic(t *testing.T) {
+	rName := "data.huaweicloud_dns_zone_file.test"
+	dc := acceptance.InitDataSourceCheck(rName)
+	name := fmt.Sprintf("acpttest-zone-%s.com.", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDNSZoneFile_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "zone_name", name),
+					resource.TestMatchResourceAttr(rName, "content",
+						regexp.MustCompile(fmt.Sprintf(`^\$ORIGIN %s\n@\t\d+\tIN\tSOA\t`, regexp.QuoteMeta(name)))),
+					resource.TestMatchResourceAttr(rName, "content",
+						regexp.MustCompile(`\nwww\t300\tIN\tA\t192\.168\.0\.1\n`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDNSZoneFile_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "test" {
+  name      = "%[1]s"
+  zone_type = "public"
+}
+
+resource "huaweicloud_dns_recordset" "test" {
+  zone_id = huaweicloud_dns_zone.test.id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["192.168.0.1"]
+}
+
+data "huaweicloud_dns_zone_file" "test" {
+  depends_on = [huaweicloud_dns_recordset.test]
+
+  zone_id = huaweicloud_dns_zone.test.id
+}
+`, name)
+}
@@ -0,0 +1,150 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDNSZoneFileResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dns", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v2.1/zones/{zone_id}/recordsets"
+	listPath = strings.ReplaceAll(listPath, "{zone_id}", state.Primary.ID)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	listResp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DNS record sets: %s", err)
+	}
+	listRespBody, err := utils.FlattenResponse(listResp)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSets := utils.PathSearch("recordsets[?default==`false`]", listRespBody, make([]interface{}, 0)).([]interface{})
+	if len(recordSets) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return recordSets, nil
+}
+
+func TestAccDNSZoneFile_basic(t *testing.T) {
+	var obj interface{}
+
+	name := fmt.Sprintf("acpttest-zone-%s.com.", acctest.RandString(5))
+	rName := "huaweicloud_dns_zone_file.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDNSZoneFileResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDNSZoneFile_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "zone_id", "huaweicloud_dns_zone.test", "id"),
+					resource.TestCheckResourceAttr(rName, "zone_name", name),
+					resource.TestCheckResourceAttr(rName, "record_sets.#", "3"),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.name", name),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.type", "MX"),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.ttl", "3600"),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.records.0", "10 mail.example.com."),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.name", fmt.Sprintf("txt.%s", name)),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.type", "TXT"),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.records.0", "\"v=spf1 -all; managed by terraform\""),
+					resource.TestCheckResourceAttr(rName, "record_sets.2.name", fmt.Sprintf("www.%s", name)),
+					resource.TestCheckResourceAttr(rName, "record_sets.2.type", "A"),
+					resource.TestCheckResourceAttr(rName, "record_sets.2.ttl", "300"),
+					resource.TestCheckResourceAttr(rName, "record_sets.2.records.#", "2"),
+				),
+			},
+			{
+				Config: testDNSZoneFile_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "record_sets.#", "2"),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.name", fmt.Sprintf("api.%s", name)),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.type", "CNAME"),
+					resource.TestCheckResourceAttr(rName, "record_sets.0.records.0", fmt.Sprintf("www.%s", name)),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.name", fmt.Sprintf("www.%s", name)),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.ttl", "600"),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.records.#", "1"),
+					resource.TestCheckResourceAttr(rName, "record_sets.1.records.0", "192.168.0.3"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func testDNSZoneFile_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "test" {
+  name      = "%s"
+  zone_type = "public"
+}
+`, name)
+}
+
+func testDNSZoneFile_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dns_zone_file" "test" {
+  zone_id = huaweicloud_dns_zone.test.id
+  content = <<EOT
+$ORIGIN %s
+$TTL 300
+@    3600 IN MX  10 mail.example.com.
+www       IN A   192.168.0.1
+www       IN A   192.168.0.2
+txt       IN TXT "v=spf1 -all; managed by terraform"
+EOT
+}
+`, testDNSZoneFile_base(name), name)
+}
+
+func testDNSZoneFile_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dns_zone_file" "test" {
+  zone_id = huaweicloud_dns_zone.test.id
+  content = <<EOT
+$TTL 600
+www IN A     192.168.0.3
+api IN CNAME www
+EOT
+}
+`, testDNSZoneFile_base(name))
+}
@@ -109,6 +109,50 @@ func TestAccDNSZone_private(t *testing.T) {
 	})
 }
 
+func TestAccDNSZone_dnssec(t *testing.T) {
+	var zone zones.Zone
+	resourceName := "huaweicloud_dns_zone.zone_1"
+	name := fmt.Sprintf("acpttest-zone-%s.com.", acctest.RandString(5))
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&zone,
+		getDNSZoneResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZone_dnssec(name, "ENABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "dnssec", "ENABLE"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_infos.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_infos.0.key_tag"),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_infos.0.digest_algorithm"),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_infos.0.digest"),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_infos.0.ds_record"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDNSZone_dnssec(name, "DISABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dnssec", "DISABLE"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_infos.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDNSZone_readTTL(t *testing.T) {
 	var zone zones.Zone
 	resourceName := "huaweicloud_dns_zone.zone_1"
@@ -195,6 +239,16 @@ resource "huaweicloud_dns_zone" "zone_1" {
 `, zoneName)
 }
 
+func testAccDNSZone_dnssec(zoneName, dnssec string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "zone_1" {
+  name      = "%s"
+  zone_type = "public"
+  dnssec    = "%s"
+}
+`, zoneName, dnssec)
+}
+
 func testAccDNSZone_readTTL(zoneName string) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_dns_zone" "zone_1" {
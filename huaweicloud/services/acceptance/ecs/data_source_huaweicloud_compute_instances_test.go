@@ -46,6 +46,13 @@ func TestAccComputeInstancesDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "instances.0.tags.foo", "bar"),
 					resource.TestCheckResourceAttr(dataSourceName, "instances.0.security_group_ids.#", "1"),
 					resource.TestCheckResourceAttr("data.huaweicloud_compute_instances.byID", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.huaweicloud_compute_instances.byTags", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.huaweicloud_compute_instances.byNotTags", "instances.#", "0"),
+					resource.TestCheckResourceAttr("data.huaweicloud_compute_instances.compact", "instances.#", "1"),
+					resource.TestCheckResourceAttrSet("data.huaweicloud_compute_instances.compact",
+						"instances.0.private_ips.0"),
+					resource.TestCheckResourceAttr("data.huaweicloud_compute_instances.compact",
+						"instances.0.network.#", "0"),
 				),
 			},
 		},
@@ -79,5 +86,26 @@ data "huaweicloud_compute_instances" "test" {
 data "huaweicloud_compute_instances" "byID" {
   instance_id = huaweicloud_compute_instance.test.id
 }
+
+data "huaweicloud_compute_instances" "byTags" {
+  name = huaweicloud_compute_instance.test.name
+
+  tags = {
+    foo = "bar"
+  }
+}
+
+data "huaweicloud_compute_instances" "byNotTags" {
+  name     = huaweicloud_compute_instance.test.name
+  not_tags = ["foo"]
+}
+
+data "huaweicloud_compute_instances" "compact" {
+  name            = huaweicloud_compute_instance.test.name
+  ip_cidrs        = [data.huaweicloud_vpc_subnet.test.cidr]
+  launched_since  = "2020-01-01T00:00:00Z"
+  max_concurrency = 5
+  compact         = true
+}
 `, testAccCompute_data, rName)
 }
@@ -0,0 +1,279 @@
+package elb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getForwardingPolicyResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NewServiceClient("elb", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/elb/l7policies/{l7policy_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{l7policy_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccElbForwardingPolicy_basic(t *testing.T) {
+	var (
+		obj   interface{}
+		name  = acceptance.RandomAccResourceName()
+		rName = "huaweicloud_elb_forwarding_policy.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getForwardingPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElbForwardingPolicy_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "listener_id", "huaweicloud_elb_listener.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "priority", "10"),
+					resource.TestCheckResourceAttr(rName, "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(rName, "conditions.#", "3"),
+					resource.TestCheckResourceAttr(rName, "conditions.0.type", "HOST_NAME"),
+					resource.TestCheckResourceAttr(rName, "conditions.1.type", "PATH"),
+					resource.TestCheckResourceAttr(rName, "conditions.1.compare_type", "STARTS_WITH"),
+					resource.TestCheckResourceAttr(rName, "conditions.2.type", "HEADER"),
+					resource.TestCheckResourceAttr(rName, "conditions.2.key", "x-env"),
+					resource.TestCheckResourceAttr(rName, "conditions.2.values.#", "2"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.pools.#", "2"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.pools.0.weight", "90"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.pools.1.weight", "10"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.rewrite_url.0.path", "/v2"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.insert_headers.0.key", "x-canary"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccElbForwardingPolicy_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "priority", "20"),
+					resource.TestCheckResourceAttr(rName, "conditions.#", "2"),
+					resource.TestCheckResourceAttr(rName, "conditions.0.type", "METHOD"),
+					resource.TestCheckResourceAttr(rName, "conditions.1.type", "SOURCE_IP"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.pools.0.weight", "50"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.pools.1.weight", "50"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.rewrite_url.#", "0"),
+					resource.TestCheckResourceAttr(rName, "forward_to_pools.0.remove_headers.0", "x-debug"),
+				),
+			},
+			{
+				Config: testAccElbForwardingPolicy_fixedResponse(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "action", "FIXED_RESPONSE"),
+					resource.TestCheckResourceAttr(rName, "fixed_response.0.status_code", "503"),
+					resource.TestCheckResourceAttr(rName, "fixed_response.0.message_body", "maintenance"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElbForwardingPolicy_duplicatePriority(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElbForwardingPolicy_fixedResponse(name),
+			},
+			{
+				Config:      testAccElbForwardingPolicy_duplicatePriority(name),
+				ExpectError: regexp.MustCompile(`the priority \(20\) has been used by the forwarding policy`),
+			},
+		},
+	})
+}
+
+func testAccElbForwardingPolicy_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_pool" "stable" {
+  name            = "%[2]s-stable"
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+}
+
+resource "huaweicloud_elb_pool" "canary" {
+  name            = "%[2]s-canary"
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+}
+`, testAccCheckElbV3L7PolicyConfig_base(name), name)
+}
+
+func testAccElbForwardingPolicy_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_forwarding_policy" "test" {
+  listener_id = huaweicloud_elb_listener.test.id
+  name        = "%[2]s"
+  priority    = 10
+
+  conditions {
+    type   = "HOST_NAME"
+    values = ["www.example.com"]
+  }
+
+  conditions {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    values       = ["/api"]
+  }
+
+  conditions {
+    type   = "HEADER"
+    key    = "x-env"
+    values = ["beta", "gamma"]
+  }
+
+  forward_to_pools {
+    pools {
+      pool_id = huaweicloud_elb_pool.stable.id
+      weight  = 90
+    }
+
+    pools {
+      pool_id = huaweicloud_elb_pool.canary.id
+      weight  = 10
+    }
+
+    rewrite_url {
+      path = "/v2"
+    }
+
+    insert_headers {
+      key   = "x-canary"
+      value = "true"
+    }
+  }
+}
+`, testAccElbForwardingPolicy_base(name), name)
+}
+
+func testAccElbForwardingPolicy_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_forwarding_policy" "test" {
+  listener_id = huaweicloud_elb_listener.test.id
+  name        = "%[2]s"
+  description = "updated by acc test"
+  priority    = 20
+
+  conditions {
+    type   = "METHOD"
+    values = ["GET", "POST"]
+  }
+
+  conditions {
+    type   = "SOURCE_IP"
+    values = ["192.168.0.0/16"]
+  }
+
+  forward_to_pools {
+    pools {
+      pool_id = huaweicloud_elb_pool.stable.id
+      weight  = 50
+    }
+
+    pools {
+      pool_id = huaweicloud_elb_pool.canary.id
+      weight  = 50
+    }
+
+    remove_headers = ["x-debug"]
+  }
+}
+`, testAccElbForwardingPolicy_base(name), name)
+}
+
+func testAccElbForwardingPolicy_fixedResponse(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_forwarding_policy" "test" {
+  listener_id = huaweicloud_elb_listener.test.id
+  name        = "%[2]s"
+  priority    = 20
+
+  conditions {
+    type   = "QUERY_STRING"
+    key    = "maintenance"
+    values = ["true"]
+  }
+
+  fixed_response {
+    status_code  = "503"
+    content_type = "text/plain"
+    message_body = "maintenance"
+  }
+}
+`, testAccElbForwardingPolicy_base(name), name)
+}
+
+func testAccElbForwardingPolicy_duplicatePriority(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_forwarding_policy" "duplicate" {
+  listener_id = huaweicloud_elb_listener.test.id
+  priority    = 20
+
+  conditions {
+    type   = "PATH"
+    values = ["/duplicate"]
+  }
+
+  redirect_to_url {
+    status_code = "301"
+    protocol    = "HTTPS"
+  }
+}
+`, testAccElbForwardingPolicy_fixedResponse(name))
+}
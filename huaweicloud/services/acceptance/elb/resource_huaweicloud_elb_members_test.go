@@ -0,0 +1,279 @@
+package elb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/pagination"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getMembersResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NewServiceClient("elb", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{pool_id}", state.Primary.ID)
+	listResp, err := pagination.ListAllItems(
+		client,
+		"marker",
+		listPath,
+		&pagination.QueryOpts{MarkerField: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	listRespJson, err := json.Marshal(listResp)
+	if err != nil {
+		return nil, err
+	}
+	var listRespBody interface{}
+	err = json.Unmarshal(listRespJson, &listRespBody)
+	if err != nil {
+		return nil, err
+	}
+
+	members := utils.PathSearch("members", listRespBody, make([]interface{}, 0)).([]interface{})
+	if len(members) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return members, nil
+}
+
+func TestAccElbMembers_basic(t *testing.T) {
+	var (
+		obj   interface{}
+		name  = acceptance.RandomAccResourceName()
+		rName = "huaweicloud_elb_members.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getMembersResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElbMembers_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "pool_id", "huaweicloud_elb_pool.test", "id"),
+					resource.TestCheckResourceAttr(rName, "members.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "members.*", map[string]string{
+						"address":       "192.168.0.10",
+						"protocol_port": "8080",
+						"weight":        "10",
+					}),
+				),
+			},
+			{
+				Config: testAccElbMembers_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "members.*", map[string]string{
+						"address": "192.168.0.10",
+						"weight":  "50",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "members.*", map[string]string{
+						"address": "192.168.0.13",
+						"weight":  "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"weight_rollout"},
+			},
+		},
+	})
+}
+
+func TestAccElbMembers_instanceSelector(t *testing.T) {
+	var (
+		obj   interface{}
+		name  = acceptance.RandomAccResourceName()
+		rName = "huaweicloud_elb_members.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getMembersResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElbMembers_instanceSelector(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "members.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(rName, "members.*.address",
+						"huaweicloud_compute_instance.test.0", "access_ip_v4"),
+					resource.TestCheckTypeSetElemAttrPair(rName, "members.*.address",
+						"huaweicloud_compute_instance.test.1", "access_ip_v4"),
+				),
+			},
+			{
+				Config: testAccElbMembers_instanceSelector(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "members.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(rName, "members.*.address",
+						"huaweicloud_compute_instance.test.2", "access_ip_v4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccElbMembers_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_loadbalancer" "test" {
+  name           = "%[2]s"
+  vpc_id         = huaweicloud_vpc.test.id
+  ipv4_subnet_id = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+
+  availability_zone = [
+    data.huaweicloud_availability_zones.test.names[0]
+  ]
+}
+
+resource "huaweicloud_elb_listener" "test" {
+  name            = "%[2]s"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+}
+
+resource "huaweicloud_elb_pool" "test" {
+  name        = "%[2]s"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_elb_listener.test.id
+}
+`, common.TestBaseComputeResources(name), name)
+}
+
+func testAccElbMembers_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_elb_members" "test" {
+  pool_id = huaweicloud_elb_pool.test.id
+
+  members {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    subnet_id     = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+    weight        = 10
+  }
+
+  members {
+    address       = "192.168.0.11"
+    protocol_port = 8080
+    subnet_id     = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+  }
+
+  members {
+    address       = "192.168.0.12"
+    protocol_port = 8080
+    subnet_id     = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+    name          = "backend-12"
+  }
+}
+`, testAccElbMembers_base(name))
+}
+
+func testAccElbMembers_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_elb_members" "test" {
+  pool_id = huaweicloud_elb_pool.test.id
+
+  members {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    subnet_id     = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+    weight        = 50
+  }
+
+  members {
+    address       = "192.168.0.13"
+    protocol_port = 8080
+    subnet_id     = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+  }
+
+  weight_rollout {
+    step     = 20
+    interval = 5
+  }
+}
+`, testAccElbMembers_base(name))
+}
+
+func testAccElbMembers_instanceSelector(name string, count int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_compute_instance" "test" {
+  count = %[3]d
+
+  name               = "%[2]s-${count.index}"
+  image_id           = data.huaweicloud_images_image.test.id
+  flavor_id          = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids = [huaweicloud_networking_secgroup.test.id]
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+
+  network {
+    uuid = huaweicloud_vpc_subnet.test.id
+  }
+
+  tags = {
+    backend = "%[2]s"
+  }
+}
+
+resource "huaweicloud_elb_members" "test" {
+  pool_id = huaweicloud_elb_pool.test.id
+
+  instance_selector {
+    tags = {
+      backend = "%[2]s"
+    }
+    protocol_port = 8080
+    subnet_id     = huaweicloud_vpc_subnet.test.ipv4_subnet_id
+    weight        = 5
+  }
+
+  depends_on = [huaweicloud_compute_instance.test]
+}
+`, testAccElbMembers_base(name), name, count)
+}
@@ -0,0 +1,125 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataEffectiveRoutes_basic(t *testing.T) {
+	var (
+		all = "data.huaweicloud_er_effective_routes.all"
+		dc  = acceptance.InitDataSourceCheck(all)
+
+		byDestination   = "data.huaweicloud_er_effective_routes.filter_by_destination"
+		dcByDestination = acceptance.InitDataSourceCheck(byDestination)
+
+		byRouteType   = "data.huaweicloud_er_effective_routes.filter_by_route_type"
+		dcByRouteType = acceptance.InitDataSourceCheck(byRouteType)
+
+		name = acceptance.RandomAccResourceName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataEffectiveRoutes_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(all, "routes.#", "2"),
+					dcByDestination.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byDestination, "routes.#", "1"),
+					resource.TestCheckResourceAttr(byDestination, "routes.0.destination", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr(byDestination, "routes.0.route_type", "propagated"),
+					resource.TestCheckResourceAttr(byDestination, "routes.0.is_blackhole", "false"),
+					resource.TestCheckResourceAttrPair(byDestination, "routes.0.next_hops.0.attachment_id",
+						"huaweicloud_er_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttrPair(byDestination, "routes.0.next_hops.0.resource_id",
+						"huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttr(byDestination, "routes.0.next_hops.0.resource_type", "vpc"),
+					dcByRouteType.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byRouteType, "routes.#", "1"),
+					resource.TestCheckResourceAttr(byRouteType, "routes.0.destination", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(byRouteType, "routes.0.is_blackhole", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataEffectiveRoutes_basic(name string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  vpc_id     = huaweicloud_vpc.test.id
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = slice(data.huaweicloud_availability_zones.test.names, 0, 1)
+
+  name                       = "%[1]s"
+  asn                        = 64512
+  enable_default_propagation = true
+  enable_default_association = true
+}
+
+resource "huaweicloud_er_vpc_attachment" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  vpc_id      = huaweicloud_vpc.test.id
+  subnet_id   = huaweicloud_vpc_subnet.test.id
+  name        = "%[1]s"
+}
+
+resource "huaweicloud_er_static_route" "test" {
+  route_table_id = huaweicloud_er_instance.test.default_association_route_table_id
+  destination    = "10.10.0.0/16"
+  is_blackhole   = true
+}
+
+data "huaweicloud_er_effective_routes" "all" {
+  depends_on = [
+    huaweicloud_er_vpc_attachment.test,
+    huaweicloud_er_static_route.test,
+  ]
+
+  route_table_id = huaweicloud_er_instance.test.default_propagation_route_table_id
+}
+
+data "huaweicloud_er_effective_routes" "filter_by_destination" {
+  depends_on = [
+    huaweicloud_er_vpc_attachment.test,
+    huaweicloud_er_static_route.test,
+  ]
+
+  route_table_id = huaweicloud_er_instance.test.default_propagation_route_table_id
+  destination    = huaweicloud_vpc_subnet.test.cidr
+}
+
+data "huaweicloud_er_effective_routes" "filter_by_route_type" {
+  depends_on = [
+    huaweicloud_er_vpc_attachment.test,
+    huaweicloud_er_static_route.test,
+  ]
+
+  route_table_id = huaweicloud_er_instance.test.default_propagation_route_table_id
+  route_type     = "static"
+}
+`, name)
+}
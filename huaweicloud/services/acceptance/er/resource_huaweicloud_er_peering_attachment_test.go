@@ -0,0 +1,62 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccPeeringAttachment_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_er_peering_attachment.test"
+		name  = acceptance.RandomAccResourceName()
+
+		rc = acceptance.InitResourceCheck(rName, &obj, getTypedAttachmentFunc)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckERPeeringAttachment(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPeeringAttachment_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "instance_id", acceptance.HW_ER_INSTANCE_ID),
+					resource.TestCheckResourceAttr(rName, "resource_id", acceptance.HW_ER_PEERING_CONNECTION_ID),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTypedAttachmentImportStateIdFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccPeeringAttachment_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_er_peering_attachment" "test" {
+  instance_id = "%[1]s"
+  resource_id = "%[2]s"
+  name        = "%[3]s"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, acceptance.HW_ER_INSTANCE_ID, acceptance.HW_ER_PEERING_CONNECTION_ID, name)
+}
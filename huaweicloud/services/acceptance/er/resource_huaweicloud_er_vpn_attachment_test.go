@@ -0,0 +1,171 @@
+package er
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The typed attachments (VPN, DC and peering) are removed from the state only when they are destroyed, so the get
+// function of them is used to check the existence only.
+func getTypedAttachmentFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ErV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	getPath := client.ResourceBaseURL() + "enterprise-router/{er_id}/attachments/{attachment_id}"
+	getPath = strings.ReplaceAll(getPath, "{er_id}", state.Primary.Attributes["instance_id"])
+	getPath = strings.ReplaceAll(getPath, "{attachment_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccVpnAttachment_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_er_vpn_attachment.test"
+		name  = acceptance.RandomAccResourceName()
+
+		rc = acceptance.InitResourceCheck(rName, &obj, getTypedAttachmentFunc)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpnAttachment_basic(name, "Created by acceptance test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_er_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "resource_id", "huaweicloud_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acceptance test"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccVpnAttachment_basic(fmt.Sprintf("%s-update", name), ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", fmt.Sprintf("%s-update", name)),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTypedAttachmentImportStateIdFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccTypedAttachmentImportStateIdFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", rName, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccVpnAttachment_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "172.16.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  vpc_id     = huaweicloud_vpc.test.id
+  cidr       = "172.16.0.0/24"
+  gateway_ip = "172.16.0.1"
+}
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = slice(data.huaweicloud_availability_zones.test.names, 0, 1)
+
+  name = "%[1]s"
+  asn  = "65000"
+}
+
+data "huaweicloud_vpn_gateway_availability_zones" "test" {
+  flavor          = "professional1"
+  attachment_type = "er"
+}
+
+resource "huaweicloud_vpn_gateway" "test" {
+  name               = "%[1]s"
+  network_type       = "private"
+  attachment_type    = "er"
+  er_id              = huaweicloud_er_instance.test.id
+  availability_zones = slice(data.huaweicloud_vpn_gateway_availability_zones.test.names, 0, 2)
+
+  access_vpc_id    = huaweicloud_vpc.test.id
+  access_subnet_id = huaweicloud_vpc_subnet.test.id
+
+  access_private_ip_1 = "172.16.0.99"
+  access_private_ip_2 = "172.16.0.100"
+}
+
+resource "huaweicloud_vpn_customer_gateway" "test" {
+  name = "%[1]s"
+  ip   = "172.16.1.100"
+}
+
+resource "huaweicloud_vpn_connection" "test" {
+  name                = "%[1]s"
+  gateway_id          = huaweicloud_vpn_gateway.test.id
+  gateway_ip          = huaweicloud_vpn_gateway.test.access_private_ip_1
+  customer_gateway_id = huaweicloud_vpn_customer_gateway.test.id
+  peer_subnets        = ["192.168.55.0/24"]
+  vpn_type            = "static"
+  psk                 = "Test@123"
+}
+`, name)
+}
+
+func testAccVpnAttachment_basic(name, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_vpn_attachment" "test" {
+  instance_id = huaweicloud_er_instance.test.id
+  resource_id = huaweicloud_vpn_connection.test.id
+  name        = "%[2]s"
+  description = "%[3]s"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccVpnAttachment_base(name), name, description)
+}
@@ -0,0 +1,45 @@
+package evs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccEvsSnapshotRollback_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_evs_snapshot_rollback.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		// The rollback is a one-time action and there is nothing to destroy.
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsSnapshotRollback_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id",
+						"huaweicloud_evs_snapshot.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id",
+						"huaweicloud_evs_volume.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvsSnapshotRollback_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_evs_snapshot_rollback" "test" {
+  snapshot_id = huaweicloud_evs_snapshot.test.id
+  volume_id   = huaweicloud_evs_volume.test.id
+  name        = "%[2]s_rollback"
+}
+`, testAccEvsSnapshotV2_basic(rName), rName)
+}
@@ -289,6 +289,13 @@ func TestAccEvsVolume_GPSSD2(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "throughput", "150"),
 				),
 			},
+			{
+				Config: testAccEvsVolume_retypeWithoutQoS(rName, "SSD"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "SSD"),
+				),
+			},
 		},
 	})
 }
@@ -334,6 +341,62 @@ func TestAccEvsVolume_ESSD2(t *testing.T) {
 	})
 }
 
+func TestAccEvsVolume_retype(t *testing.T) {
+	var volume cloudvolumes.Volume
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_evs_volume.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&volume,
+		getVolumeResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			// Type GPSSD2 is only supported in part availability_zones under the certain region.
+			acceptance.TestAccPreCheckAvailabilityZoneGPSSD2(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsVolume_retype(rName, "SSD", 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "SSD"),
+				),
+			},
+			{
+				Config: testAccEvsVolume_retype(rName, "GPSSD2", 3000, 125),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "GPSSD2"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "125"),
+				),
+			},
+			{
+				Config: testAccEvsVolume_retype(rName, "GPSSD2", 4000, 150),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "GPSSD2"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "4000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "150"),
+				),
+			},
+			{
+				Config: testAccEvsVolume_retypeWithoutQoS(rName, "SSD"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "SSD"),
+				),
+			},
+		},
+	})
+}
+
 func testAccEvsVolume_base() string {
 	return `
 variable "volume_configuration" {
@@ -653,3 +716,29 @@ resource "huaweicloud_evs_volume" "test" {
 }
 `, rName, acceptance.HW_EVS_AVAILABILITY_ZONE_ESSD2)
 }
+
+func testAccEvsVolume_retype(rName, volumeType string, iops, throughput int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_evs_volume" "test" {
+  name              = "%[1]s"
+  description       = "test volume for changing the volume type"
+  availability_zone = "%[2]s"
+  size              = 100
+  volume_type       = "%[3]s"
+  iops              = %[4]d
+  throughput        = %[5]d
+}
+`, rName, acceptance.HW_EVS_AVAILABILITY_ZONE_GPSSD2, volumeType, iops, throughput)
+}
+
+func testAccEvsVolume_retypeWithoutQoS(rName, volumeType string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_evs_volume" "test" {
+  name              = "%[1]s"
+  description       = "test volume for changing the volume type"
+  availability_zone = "%[2]s"
+  size              = 100
+  volume_type       = "%[3]s"
+}
+`, rName, acceptance.HW_EVS_AVAILABILITY_ZONE_GPSSD2, volumeType)
+}
@@ -0,0 +1,251 @@
+package nat
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/pagination"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPublicRules(cfg *config.Config, ruleType, gatewayId string) (interface{}, error) {
+	client, err := cfg.NatGatewayClient(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v2/{project_id}/{rule_type}?nat_gateway_id={nat_gateway_id}"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{rule_type}", ruleType)
+	listPath = strings.ReplaceAll(listPath, "{nat_gateway_id}", gatewayId)
+	listResp, err := pagination.ListAllItems(
+		client,
+		"marker",
+		listPath,
+		&pagination.QueryOpts{MarkerField: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	listRespJson, err := json.Marshal(listResp)
+	if err != nil {
+		return nil, err
+	}
+	var listRespBody interface{}
+	err = json.Unmarshal(listRespJson, &listRespBody)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := utils.PathSearch(ruleType, listRespBody, make([]interface{}, 0)).([]interface{})
+	if len(rules) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rules, nil
+}
+
+func getPublicDnatRulesResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getPublicRules(cfg, "dnat_rules", state.Primary.ID)
+}
+
+func TestAccPublicDnatRules_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_nat_dnat_rules.test"
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPublicDnatRulesResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicDnatRules_basic_step_1(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "nat_gateway_id", "huaweicloud_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttr(rName, "rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":              "tcp",
+						"internal_service_port": "80",
+						"external_service_port": "8080",
+						"description":           "Created by acc test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":              "udp",
+						"internal_service_port": "53",
+						"external_service_port": "5353",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":                    "tcp",
+						"internal_service_port_range": "23-823",
+						"external_service_port_range": "8023-8823",
+					}),
+				),
+			},
+			{
+				Config: testAccPublicDnatRules_basic_step_2(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":              "tcp",
+						"internal_service_port": "8080",
+						"external_service_port": "8080",
+						"description":           "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":              "tcp",
+						"internal_service_port": "443",
+						"external_service_port": "8443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":                    "tcp",
+						"internal_service_port_range": "23-823",
+						"external_service_port_range": "8023-8823",
+					}),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPublicDnatRules_portConflict(t *testing.T) {
+	name := acceptance.RandomAccResourceNameWithDash()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicDnatRule_base(name),
+			},
+			{
+				Config:      testAccPublicDnatRules_portConflict(name),
+				ExpectError: regexp.MustCompile(`the DNAT rules conflict with each other`),
+			},
+		},
+	})
+}
+
+func testAccPublicDnatRules_basic_step_1(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_dnat_rules" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    port_id               = huaweicloud_compute_instance.test.network[0].port
+    protocol              = "tcp"
+    internal_service_port = 80
+    external_service_port = 8080
+    description           = "Created by acc test"
+  }
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    port_id               = huaweicloud_compute_instance.test.network[0].port
+    protocol              = "udp"
+    internal_service_port = 53
+    external_service_port = 5353
+  }
+
+  rules {
+    floating_ip_id              = huaweicloud_vpc_eip.test.id
+    port_id                     = huaweicloud_compute_instance.test.network[0].port
+    protocol                    = "tcp"
+    internal_service_port_range = "23-823"
+    external_service_port_range = "8023-8823"
+  }
+}
+`, testAccPublicDnatRule_base(name))
+}
+
+func testAccPublicDnatRules_basic_step_2(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_dnat_rules" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    port_id               = huaweicloud_compute_instance.test.network[0].port
+    protocol              = "tcp"
+    internal_service_port = 8080
+    external_service_port = 8080
+  }
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    port_id               = huaweicloud_compute_instance.test.network[0].port
+    protocol              = "tcp"
+    internal_service_port = 443
+    external_service_port = 8443
+  }
+
+  rules {
+    floating_ip_id              = huaweicloud_vpc_eip.test.id
+    port_id                     = huaweicloud_compute_instance.test.network[0].port
+    protocol                    = "tcp"
+    internal_service_port_range = "23-823"
+    external_service_port_range = "8023-8823"
+  }
+}
+`, testAccPublicDnatRule_base(name))
+}
+
+func testAccPublicDnatRules_portConflict(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_dnat_rules" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    port_id               = huaweicloud_compute_instance.test.network[0].port
+    protocol              = "tcp"
+    internal_service_port = 80
+    external_service_port = 8080
+  }
+
+  rules {
+    floating_ip_id              = huaweicloud_vpc_eip.test.id
+    port_id                     = huaweicloud_compute_instance.test.network[0].port
+    protocol                    = "tcp"
+    internal_service_port_range = "8000-8100"
+    external_service_port_range = "8000-8100"
+  }
+}
+`, testAccPublicDnatRule_base(name))
+}
@@ -0,0 +1,113 @@
+package nat
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getPublicSnatRulesResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getPublicRules(cfg, "snat_rules", state.Primary.ID)
+}
+
+func TestAccPublicSnatRules_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_nat_snat_rules.test"
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPublicSnatRulesResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicSnatRules_basic_step_1(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "nat_gateway_id", "huaweicloud_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttr(rName, "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"cidr":        "192.168.10.0/24",
+						"source_type": "1",
+						"description": "Created by acc test",
+					}),
+				),
+			},
+			{
+				Config: testAccPublicSnatRules_basic_step_2(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"cidr":        "192.168.20.0/24",
+						"source_type": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPublicSnatRules_basic_step_1(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_snat_rules" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    subnet_id      = huaweicloud_vpc_subnet.test.id
+    floating_ip_id = huaweicloud_vpc_eip.test[0].id
+  }
+
+  rules {
+    source_type    = 1
+    cidr           = "192.168.10.0/24"
+    floating_ip_id = huaweicloud_vpc_eip.test[1].id
+    description    = "Created by acc test"
+  }
+}
+`, testAccPublicSnatRule_base(name))
+}
+
+func testAccPublicSnatRules_basic_step_2(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_snat_rules" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    subnet_id      = huaweicloud_vpc_subnet.test.id
+    floating_ip_id = join(",", huaweicloud_vpc_eip.test[*].id)
+  }
+
+  rules {
+    source_type    = 1
+    cidr           = "192.168.20.0/24"
+    floating_ip_id = huaweicloud_vpc_eip.test[1].id
+  }
+}
+`, testAccPublicSnatRule_base(name))
+}
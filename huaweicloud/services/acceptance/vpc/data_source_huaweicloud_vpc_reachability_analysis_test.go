@@ -0,0 +1,121 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccVpcReachabilityAnalysisDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	allowedName := "data.huaweicloud_vpc_reachability_analysis.allowed"
+	deniedName := "data.huaweicloud_vpc_reachability_analysis.denied"
+	dcAllowed := acceptance.InitDataSourceCheck(allowedName)
+	dcDenied := acceptance.InitDataSourceCheck(deniedName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceReachabilityAnalysis_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dcAllowed.CheckResourceExists(),
+					resource.TestCheckResourceAttr(allowedName, "reachable", "true"),
+					resource.TestCheckResourceAttr(allowedName, "hops.#", "5"),
+					resource.TestCheckResourceAttr(allowedName, "hops.0.type", "source_security_group"),
+					resource.TestCheckResourceAttr(allowedName, "hops.0.verdict", "allow"),
+					resource.TestCheckResourceAttr(allowedName, "hops.2.type", "route"),
+					resource.TestCheckResourceAttr(allowedName, "hops.2.matched_rule", "local"),
+					resource.TestCheckResourceAttr(allowedName, "hops.4.verdict", "allow"),
+					resource.TestCheckResourceAttrSet(allowedName, "hops.4.matched_rule"),
+
+					dcDenied.CheckResourceExists(),
+					resource.TestCheckResourceAttr(deniedName, "reachable", "false"),
+					resource.TestCheckResourceAttr(deniedName, "hops.4.type", "destination_security_group"),
+					resource.TestCheckResourceAttr(deniedName, "hops.4.verdict", "deny"),
+					resource.TestCheckResourceAttrSet(deniedName, "hops.4.matched_rule"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceReachabilityAnalysis_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "source" {
+  name       = "%[1]s_source"
+  cidr       = "192.168.10.0/24"
+  gateway_ip = "192.168.10.1"
+  vpc_id     = huaweicloud_vpc.test.id
+}
+
+resource "huaweicloud_vpc_subnet" "destination" {
+  name       = "%[1]s_destination"
+  cidr       = "192.168.20.0/24"
+  gateway_ip = "192.168.20.1"
+  vpc_id     = huaweicloud_vpc.test.id
+}
+
+resource "huaweicloud_networking_secgroup" "source" {
+  name = "%[1]s_source"
+
+  rules {
+    direction        = "egress"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+
+resource "huaweicloud_networking_secgroup" "destination" {
+  name = "%[1]s_destination"
+
+  rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "22"
+    remote_ip_prefix = "192.168.10.0/24"
+  }
+  rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "3389"
+    remote_ip_prefix = "0.0.0.0/0"
+    action           = "deny"
+  }
+}
+
+resource "huaweicloud_vpc_network_interface" "source" {
+  name               = "%[1]s_source"
+  subnet_id          = huaweicloud_vpc_subnet.source.id
+  security_group_ids = [huaweicloud_networking_secgroup.source.id]
+}
+
+resource "huaweicloud_vpc_network_interface" "destination" {
+  name               = "%[1]s_destination"
+  subnet_id          = huaweicloud_vpc_subnet.destination.id
+  security_group_ids = [huaweicloud_networking_secgroup.destination.id]
+}
+
+data "huaweicloud_vpc_reachability_analysis" "allowed" {
+  source_port_id      = huaweicloud_vpc_network_interface.source.id
+  destination_port_id = huaweicloud_vpc_network_interface.destination.id
+  protocol            = "tcp"
+  destination_port    = 22
+}
+
+data "huaweicloud_vpc_reachability_analysis" "denied" {
+  source_port_id      = huaweicloud_vpc_network_interface.source.id
+  destination_port_id = huaweicloud_vpc_network_interface.destination.id
+  protocol            = "tcp"
+  destination_port    = 3389
+}
+`, rName)
+}
@@ -0,0 +1,138 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v3/security/groups"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getNetworkSecGroupRulesResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NetworkingV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC network v3 client: %s", err)
+	}
+
+	secGroup, err := groups.Get(client, state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(secGroup.SecurityGroupRules) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return secGroup, nil
+}
+
+func TestAccNetworkingSecGroupRules_basic(t *testing.T) {
+	var secGroup groups.SecurityGroup
+	name := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_networking_secgroup_rules.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&secGroup,
+		getNetworkSecGroupRulesResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecGroupRules_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id",
+						"huaweicloud_networking_secgroup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rules.*", map[string]string{
+						"direction": "ingress",
+						"ports":     "80,443",
+						"priority":  "5",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rules.*.remote_address_group_id",
+						"huaweicloud_vpc_address_group.test", "id"),
+				),
+			},
+			{
+				Config: testAccSecGroupRules_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rules.*", map[string]string{
+						"direction": "egress",
+						"action":    "deny",
+						"protocol":  "udp",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecGroupRules_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_vpc_address_group" "test" {
+  name      = "%[1]s"
+  addresses = ["192.168.10.12", "192.168.11.0-192.168.11.240"]
+}
+`, name)
+}
+
+func testAccSecGroupRules_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_networking_secgroup_rules" "test" {
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  rules {
+    direction               = "ingress"
+    protocol                = "tcp"
+    ports                   = "80,443"
+    remote_address_group_id = huaweicloud_vpc_address_group.test.id
+    priority                = 5
+  }
+  rules {
+    direction        = "egress"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`, testAccSecGroupRules_base(name))
+}
+
+func testAccSecGroupRules_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_networking_secgroup_rules" "test" {
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  rules {
+    direction        = "egress"
+    protocol         = "udp"
+    ports            = "53"
+    remote_ip_prefix = "10.0.0.0/8"
+    action           = "deny"
+    description      = "Deny DNS queries"
+  }
+}
+`, testAccSecGroupRules_base(name))
+}
@@ -156,3 +156,105 @@ resource "huaweicloud_networking_secgroup" "secgroup_1" {
 }
 `, name)
 }
+
+func TestAccNetworkingV3SecGroup_inlineRules(t *testing.T) {
+	var secGroup securitygroups.SecurityGroup
+	name := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_networking_secgroup.secgroup_1"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&secGroup,
+		getNetworkSecGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecGroup_inlineRules(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "managed_rules.*", map[string]string{
+						"direction": "ingress",
+						"protocol":  "tcp",
+						"ports":     "22,3389",
+						"priority":  "10",
+					}),
+				),
+			},
+			{
+				Config: testAccSecGroup_inlineRulesUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "managed_rules.*", map[string]string{
+						"direction": "ingress",
+						"protocol":  "tcp",
+						"ports":     "443",
+						"action":    "deny",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecGroup_inlineRules(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup" "secgroup_1" {
+  name        = "%s"
+  description = "security group acceptance test with inline rules"
+
+  managed_rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "22,3389"
+    remote_ip_prefix = "192.168.0.0/16"
+    priority         = 10
+  }
+  managed_rules {
+    direction        = "egress"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`, name)
+}
+
+func testAccSecGroup_inlineRulesUpdate(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup" "secgroup_1" {
+  name        = "%s"
+  description = "security group acceptance test with inline rules"
+
+  managed_rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "22"
+    remote_ip_prefix = "192.168.0.0/16"
+    priority         = 10
+  }
+  managed_rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "443"
+    remote_ip_prefix = "10.0.0.0/8"
+    action           = "deny"
+  }
+  managed_rules {
+    direction        = "egress"
+    ethertype        = "IPv6"
+    remote_ip_prefix = "::/0"
+  }
+}
+`, name)
+}
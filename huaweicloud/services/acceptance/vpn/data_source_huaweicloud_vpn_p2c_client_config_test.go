@@ -0,0 +1,59 @@
+package vpn
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceP2CClientConfig_basic(t *testing.T) {
+	var (
+		name           = acceptance.RandomAccResourceName()
+		dataSourceName = "data.huaweicloud_vpn_p2c_client_config.test"
+		dc             = acceptance.InitDataSourceCheck(dataSourceName)
+
+		withCert   = "data.huaweicloud_vpn_p2c_client_config.with_cert"
+		dcWithCert = acceptance.InitDataSourceCheck(withCert)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckVPNP2CServerCertificate(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceP2CClientConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSourceName, "client_config"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "openvpn_profile", dataSourceName, "client_config"),
+					dcWithCert.CheckResourceExists(),
+					resource.TestMatchResourceAttr(withCert, "openvpn_profile", regexp.MustCompile(`<cert>`)),
+					resource.TestMatchResourceAttr(withCert, "openvpn_profile", regexp.MustCompile(`<key>`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceP2CClientConfig_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpn_p2c_client_config" "test" {
+  vpn_server_id = huaweicloud_vpn_server.test.id
+}
+
+data "huaweicloud_vpn_p2c_client_config" "with_cert" {
+  vpn_server_id      = huaweicloud_vpn_server.test.id
+  client_certificate = data.huaweicloud_ccm_private_certificate_export.test.certificate
+  client_private_key = data.huaweicloud_ccm_private_certificate_export.test.private_key
+}
+`, testServer_basic(name))
+}
@@ -0,0 +1,132 @@
+package vpn
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getP2CGatewayResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NewServiceClient("vpn", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPN client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{p2c_vgw_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving P2C VPN gateway: %s", err)
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccP2CGateway_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_vpn_p2c_gateway.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getP2CGatewayResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testP2CGateway_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrPair(rName, "vpc_id", "huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "connect_subnet", "huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "eip.0.id", "huaweicloud_vpc_eip.test1", "id"),
+					resource.TestCheckResourceAttrSet(rName, "eip.0.ip_address"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "val"),
+					resource.TestCheckResourceAttrSet(rName, "max_connection_number"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testP2CGateway_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-update"),
+					resource.TestCheckResourceAttr(rName, "tags.key", "val-update"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testP2CGateway_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpn_p2c_gateway" "test" {
+  name               = "%s"
+  vpc_id             = huaweicloud_vpc.test.id
+  connect_subnet     = huaweicloud_vpc_subnet.test.id
+  availability_zones = [
+    data.huaweicloud_vpn_gateway_availability_zones.test.names[0],
+  ]
+
+  eip {
+    id = huaweicloud_vpc_eip.test1.id
+  }
+
+  tags = {
+    key = "val"
+  }
+}
+`, testGateway_base(name), name)
+}
+
+func testP2CGateway_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpn_p2c_gateway" "test" {
+  name               = "%s-update"
+  vpc_id             = huaweicloud_vpc.test.id
+  connect_subnet     = huaweicloud_vpc_subnet.test.id
+  availability_zones = [
+    data.huaweicloud_vpn_gateway_availability_zones.test.names[0],
+  ]
+
+  eip {
+    id = huaweicloud_vpc_eip.test1.id
+  }
+
+  tags = {
+    key = "val-update"
+    foo = "bar"
+  }
+}
+`, testGateway_base(name), name)
+}
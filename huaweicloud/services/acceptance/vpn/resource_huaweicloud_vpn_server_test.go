@@ -0,0 +1,205 @@
+package vpn
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getServerResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NewServiceClient("vpn", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPN client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}/vpn-servers"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{p2c_vgw_id}", state.Primary.Attributes["p2c_vgw_id"])
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	listResp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving VPN server: %s", err)
+	}
+	listRespBody, err := utils.FlattenResponse(listResp)
+	if err != nil {
+		return nil, err
+	}
+
+	server := utils.PathSearch(fmt.Sprintf("vpn_servers[?id=='%s']|[0]", state.Primary.ID), listRespBody, nil)
+	if server == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return server, nil
+}
+
+func TestAccServer_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_vpn_server.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getServerResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckVPNP2CServerCertificate(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		// The VPN server is deleted along with the P2C VPN gateway.
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testServer_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "p2c_vgw_id", "huaweicloud_vpn_p2c_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "local_subnets.0", "huaweicloud_vpc_subnet.test", "cidr"),
+					resource.TestCheckResourceAttr(rName, "client_cidr", "172.16.0.0/16"),
+					resource.TestCheckResourceAttr(rName, "client_auth_type", "CERT"),
+					resource.TestCheckResourceAttr(rName, "server_certificate_id",
+						acceptance.HW_VPN_P2C_SERVER_CERTIFICATE_ID),
+					resource.TestCheckResourceAttr(rName, "client_ca_certificates.#", "1"),
+					resource.TestCheckResourceAttr(rName, "client_ca_certificates.0.name", name),
+					resource.TestCheckResourceAttrSet(rName, "client_ca_certificates.0.id"),
+					resource.TestCheckResourceAttr(rName, "ssl_options.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(rName, "ssl_options.0.port", "443"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testServer_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "local_subnets.#", "2"),
+					resource.TestCheckResourceAttr(rName, "client_cidr", "172.17.0.0/16"),
+					resource.TestCheckResourceAttr(rName, "client_auth_type", "LOCAL_PASSWORD"),
+					resource.TestCheckResourceAttr(rName, "client_ca_certificates.#", "0"),
+					resource.TestCheckResourceAttr(rName, "ssl_options.0.protocol", "UDP"),
+					resource.TestCheckResourceAttr(rName, "ssl_options.0.port", "1194"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testServerImportStateIdFunc(rName),
+			},
+		},
+	})
+}
+
+func testServerImportStateIdFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", rName, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["p2c_vgw_id"], rs.Primary.ID), nil
+	}
+}
+
+func testServer_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_ccm_private_ca" "test" {
+  type = "ROOT"
+
+  distinguished_name {
+    common_name         = "%[2]s"
+    country             = "CN"
+    state               = "GD"
+    locality            = "SZ"
+    organization        = "huawei"
+    organizational_unit = "cloud"
+  }
+
+  key_algorithm       = "RSA2048"
+  signature_algorithm = "SHA256"
+  pending_days        = "7"
+
+  validity {
+    type  = "DAY"
+    value = 5
+  }
+}
+
+resource "huaweicloud_ccm_private_certificate" "test" {
+  issuer_id           = huaweicloud_ccm_private_ca.test.id
+  key_algorithm       = "RSA2048"
+  signature_algorithm = "SHA256"
+
+  distinguished_name {
+    common_name = "%[2]s-client"
+  }
+
+  validity {
+    type  = "DAY"
+    value = 1
+  }
+}
+
+data "huaweicloud_ccm_private_certificate_export" "test" {
+  type           = "other"
+  certificate_id = huaweicloud_ccm_private_certificate.test.id
+}
+`, testP2CGateway_basic(name), name)
+}
+
+func testServer_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpn_server" "test" {
+  p2c_vgw_id            = huaweicloud_vpn_p2c_gateway.test.id
+  local_subnets         = [huaweicloud_vpc_subnet.test.cidr]
+  client_cidr           = "172.16.0.0/16"
+  server_certificate_id = "%[2]s"
+
+  client_ca_certificates {
+    name    = "%[3]s"
+    content = data.huaweicloud_ccm_private_certificate_export.test.certificate_chain
+  }
+
+  ssl_options {
+    protocol = "TCP"
+    port     = 443
+  }
+}
+`, testServer_base(name), acceptance.HW_VPN_P2C_SERVER_CERTIFICATE_ID, name)
+}
+
+func testServer_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpn_server" "test" {
+  p2c_vgw_id            = huaweicloud_vpn_p2c_gateway.test.id
+  local_subnets         = [huaweicloud_vpc_subnet.test.cidr, "192.168.2.0/24"]
+  client_cidr           = "172.17.0.0/16"
+  server_certificate_id = "%[2]s"
+  client_auth_type      = "LOCAL_PASSWORD"
+
+  ssl_options {
+    protocol = "UDP"
+    port     = 1194
+  }
+}
+`, testServer_base(name), acceptance.HW_VPN_P2C_SERVER_CERTIFICATE_ID)
+}
@@ -49,8 +49,11 @@ func ResourceASGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateInstanceRefresh,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -233,6 +236,37 @@ func ResourceASGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"instance_refresh": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      90,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"pause_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 3600),
+							Description:  "The waiting time between two batches, in seconds.",
+						},
+						"wait_for_elb_health": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"tags": common.TagsSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
@@ -769,9 +803,230 @@ func resourceASGroupUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("scaling_configuration_id") {
+		if err := refreshGroupInstances(ctx, asClient, d); err != nil {
+			// Keep the old configuration ID in the state, so the refresh is resumed by the next apply.
+			d.Partial(true)
+			return diag.Errorf("error refreshing instances of AS group %s: %s", asgID, err)
+		}
+	}
+
 	return resourceASGroupRead(ctx, d, meta)
 }
 
+// validateInstanceRefresh checks the instance refresh configuration at plan time.
+func validateInstanceRefresh(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("instance_refresh.0.wait_for_elb_health").(bool) &&
+		d.Get("health_periodic_audit_method").(string) != "ELB_AUDIT" {
+		return fmt.Errorf("`wait_for_elb_health` is only available when `health_periodic_audit_method` is ELB_AUDIT")
+	}
+	return nil
+}
+
+// refreshGroupInstances replaces the instances created by the old AS configurations batch by batch.
+// For each batch, new instances are launched before the old ones are removed if the max instance number allows,
+// otherwise the old instances are removed first and the number of removed instances is limited by the minimum
+// healthy percentage.
+func refreshGroupInstances(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	if _, ok := d.GetOk("instance_refresh"); !ok {
+		return nil
+	}
+	groupID := d.Id()
+	if !d.Get("enable").(bool) {
+		log.Printf("[WARN] the AS group (%s) is disabled, skip refreshing the instances", groupID)
+		return nil
+	}
+
+	var (
+		configurationID = d.Get("scaling_configuration_id").(string)
+		minNum          = d.Get("min_instance_number").(int)
+		maxNum          = d.Get("max_instance_number").(int)
+		minHealthy      = d.Get("instance_refresh.0.min_healthy_percentage").(int)
+		batchSize       = d.Get("instance_refresh.0.batch_size").(int)
+		pauseTime       = d.Get("instance_refresh.0.pause_time").(int)
+		waitForHealth   = d.Get("instance_refresh.0.wait_for_elb_health").(bool)
+		timeout         = d.Timeout(schema.TimeoutUpdate)
+	)
+
+	for {
+		allIns, err := getInstancesInGroup(client, groupID, nil)
+		if err != nil {
+			return err
+		}
+		oldIDs := getOldConfigurationInstanceIDs(allIns, configurationID)
+		if len(oldIDs) == 0 {
+			return nil
+		}
+
+		total := len(allIns)
+		batch := batchSize
+		if batch > len(oldIDs) {
+			batch = len(oldIDs)
+		}
+
+		if total+batch <= maxNum {
+			log.Printf("[DEBUG] launching %d new instances before removing the old instances of AS group %s",
+				batch, groupID)
+			if err = updateGroupDesireNumber(client, groupID, total+batch, minNum, maxNum); err != nil {
+				return err
+			}
+			if err = waitForRefreshedInstances(ctx, client, groupID, total+batch, waitForHealth, timeout); err != nil {
+				return err
+			}
+			if err = removeGroupInstances(ctx, client, groupID, oldIDs[:batch], timeout); err != nil {
+				return err
+			}
+		} else {
+			// Round up the number of instances which must keep healthy during the refresh.
+			healthyNum := (total*minHealthy + 99) / 100
+			if healthyNum < minNum {
+				healthyNum = minNum
+			}
+			if total-batch < healthyNum {
+				batch = total - healthyNum
+			}
+			if batch < 1 {
+				return fmt.Errorf("unable to replace any instance without violating the minimum healthy percentage "+
+					"(%d%%) or the min instance number (%d), please increase max_instance_number or decrease "+
+					"min_healthy_percentage", minHealthy, minNum)
+			}
+
+			log.Printf("[DEBUG] removing %d old instances before launching the new instances of AS group %s",
+				batch, groupID)
+			if err = removeGroupInstances(ctx, client, groupID, oldIDs[:batch], timeout); err != nil {
+				return err
+			}
+			if err = updateGroupDesireNumber(client, groupID, total, minNum, maxNum); err != nil {
+				return err
+			}
+			if err = waitForRefreshedInstances(ctx, client, groupID, total, waitForHealth, timeout); err != nil {
+				return err
+			}
+		}
+
+		if pauseTime > 0 && len(oldIDs) > batch {
+			log.Printf("[DEBUG] waiting %d seconds before refreshing the next batch of AS group %s", pauseTime, groupID)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("error waiting for the next batch of AS group %s: %s", groupID, ctx.Err())
+			case <-time.After(time.Duration(pauseTime) * time.Second):
+			}
+		}
+	}
+}
+
+// The life cycle status of the instances which are put into standby manually.
+var standbyInstanceStatus = []string{"ENTERING_STANDBY", "STANDBY"}
+
+// getOldConfigurationInstanceIDs returns the IDs of the instances created by the old AS configurations, the instances
+// in standby and the instances protected from scaling down are skipped, they are kept until they are removed manually.
+func getOldConfigurationInstanceIDs(allIns []instances.Instance, configurationID string) []string {
+	oldIDs := make([]string, 0)
+	for _, ins := range allIns {
+		if ins.ID == "" || ins.ConfigurationID == configurationID {
+			continue
+		}
+		if ins.Protected || utils.StrSliceContains(standbyInstanceStatus, ins.LifeCycleStatus) {
+			log.Printf("[WARN] the instance %s is protected or in standby (%s), skip refreshing it", ins.ID,
+				ins.LifeCycleStatus)
+			continue
+		}
+		oldIDs = append(oldIDs, ins.ID)
+	}
+	return oldIDs
+}
+
+func updateGroupDesireNumber(client *golangsdk.ServiceClient, groupID string, desireNum, minNum, maxNum int) error {
+	updateOpts := groups.UpdateOpts{
+		DesireInstanceNumber: desireNum,
+		MinInstanceNumber:    minNum,
+		MaxInstanceNumber:    maxNum,
+	}
+	if _, err := groups.Update(client, groupID, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating the desire instance number to %d: %s", desireNum, err)
+	}
+	return nil
+}
+
+// removeGroupInstances removes the instances from the AS group and deletes them.
+func removeGroupInstances(ctx context.Context, client *golangsdk.ServiceClient, groupID string, instanceIDs []string,
+	timeout time.Duration) error {
+	if err := instances.BatchDelete(client, groupID, instanceIDs, "yes").ExtractErr(); err != nil {
+		return fmt.Errorf("error removing instances %v: %s", instanceIDs, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      refreshInstancesRemoved(client, groupID, instanceIDs),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func refreshInstancesRemoved(client *golangsdk.ServiceClient, groupID string, instanceIDs []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allIns, err := getInstancesInGroup(client, groupID, nil)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		for _, ins := range allIns {
+			if utils.StrSliceContains(instanceIDs, ins.ID) {
+				return allIns, "PENDING", nil
+			}
+		}
+		return allIns, "COMPLETED", nil
+	}
+}
+
+// waitForRefreshedInstances waits for the instances of the AS group to reach the expected number and become INSERVICE,
+// the instances suspended by lifecycle hooks (PENDING_WAIT and REMOVING_WAIT) are also treated as pending.
+// The instances in standby are counted in the number, but they are not expected to become INSERVICE.
+func waitForRefreshedInstances(ctx context.Context, client *golangsdk.ServiceClient, groupID string, insNum int,
+	waitForHealth bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      refreshInstancesInService(client, groupID, insNum, waitForHealth),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func refreshInstancesInService(client *golangsdk.ServiceClient, groupID string, insNum int,
+	waitForHealth bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allIns, err := getInstancesInGroup(client, groupID, nil)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		if len(allIns) != insNum {
+			return allIns, "PENDING", nil
+		}
+		for _, ins := range allIns {
+			if utils.StrSliceContains(standbyInstanceStatus, ins.LifeCycleStatus) {
+				continue
+			}
+			if ins.LifeCycleStatus != "INSERVICE" {
+				return allIns, "PENDING", nil
+			}
+			if waitForHealth && ins.HealthStatus != "NORMAL" {
+				if ins.HealthStatus == "ERROR" {
+					return allIns, "ERROR", fmt.Errorf("the health status of instance %s is ERROR", ins.ID)
+				}
+				return allIns, "PENDING", nil
+			}
+		}
+		return allIns, "COMPLETED", nil
+	}
+}
+
 func resourceASGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conf := meta.(*config.Config)
 	asClient, err := conf.AutoscalingV1Client(conf.GetRegion(d))
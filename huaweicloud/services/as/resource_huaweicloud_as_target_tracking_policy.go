@@ -0,0 +1,488 @@
+package as
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/policies"
+	"github.com/chnsz/golangsdk/openstack/cloudeyeservice/v2/alarmrule"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const (
+	targetTrackingScaleOut = "scale_out"
+	targetTrackingScaleIn  = "scale_in"
+)
+
+// @API AS POST /autoscaling-api/v1/{project_id}/scaling_policy
+// @API AS GET /autoscaling-api/v1/{project_id}/scaling_policy/{id}
+// @API AS PUT /autoscaling-api/v1/{project_id}/scaling_policy/{id}
+// @API AS DELETE /autoscaling-api/v1/{project_id}/scaling_policy/{id}
+// @API CES POST /v2/{project_id}/alarms
+// @API CES GET /v2/{project_id}/alarms
+// @API CES PUT /v2/{project_id}/alarms/{id}/policies
+// @API CES POST /v2/{project_id}/alarms/batch-delete
+func ResourceASTargetTrackingPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceASTargetTrackingPolicyCreate,
+		ReadContext:   resourceASTargetTrackingPolicyRead,
+		UpdateContext: resourceASTargetTrackingPolicyUpdate,
+		DeleteContext: resourceASTargetTrackingPolicyDelete,
+
+		CustomizeDiff: resourceASTargetTrackingPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 55),
+					validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa50-9a-zA-Z-_]+$"),
+						"only letters, digits, underscores (_), and hyphens (-) are allowed"),
+				),
+			},
+			"target_value": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cpu_util",
+			},
+			"scale_in_threshold": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "The metric value below which the instances are removed, defaults to 90% of the target.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntInSlice([]int{60, 300, 1200, 3600, 14400, 86400}),
+			},
+			"evaluation_periods": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"scale_out_instance_number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"scale_in_instance_number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"cool_down_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(0, 86400),
+			},
+			"disable_scale_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"scale_out_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale_out_alarm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale_in_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale_in_alarm_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getTargetTrackingScaleInThreshold(d *schema.ResourceData) float64 {
+	if v, ok := d.GetOk("scale_in_threshold"); ok {
+		return v.(float64)
+	}
+	return d.Get("target_value").(float64) * 0.9
+}
+
+func buildTargetTrackingAlarmPolicies(d *schema.ResourceData, direction string) []alarmrule.PolicyOpts {
+	policy := alarmrule.PolicyOpts{
+		MetricName:         d.Get("metric_name").(string),
+		Period:             d.Get("period").(int),
+		Filter:             "average",
+		ComparisonOperator: ">",
+		Value:              d.Get("target_value").(float64),
+		Count:              d.Get("evaluation_periods").(int),
+		Level:              2,
+	}
+	if direction == targetTrackingScaleIn {
+		policy.ComparisonOperator = "<"
+		policy.Value = getTargetTrackingScaleInThreshold(d)
+	}
+	return []alarmrule.PolicyOpts{policy}
+}
+
+func createTargetTrackingAlarm(client *golangsdk.ServiceClient, d *schema.ResourceData, direction string) (string, error) {
+	createOpts := alarmrule.CreateOpts{
+		Name:      fmt.Sprintf("%s_%s", d.Get("name").(string), direction),
+		Namespace: "SYS.AS",
+		Type:      "MULTI_INSTANCE",
+		Resources: [][]alarmrule.DimensionOpts{
+			{
+				{
+					Name:  "AutoScalingGroup",
+					Value: d.Get("scaling_group_id").(string),
+				},
+			},
+		},
+		Policies: buildTargetTrackingAlarmPolicies(d, direction),
+		AlarmNotifications: []alarmrule.NotificationOpts{
+			{
+				Type:             "autoscaling",
+				NotificationList: []string{},
+			},
+		},
+		Enabled:             true,
+		NotificationEnabled: true,
+	}
+
+	log.Printf("[DEBUG] Create CES alarm rule options of target tracking policy: %#v", createOpts)
+	resp, err := alarmrule.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error creating the %s alarm rule: %s", direction, err)
+	}
+	return resp.AlarmID, nil
+}
+
+func createTargetTrackingPolicy(client *golangsdk.ServiceClient, d *schema.ResourceData, direction,
+	alarmId string) (string, error) {
+	action := policies.ActionOpts{
+		Operation:   "ADD",
+		InstanceNum: d.Get("scale_out_instance_number").(int),
+	}
+	if direction == targetTrackingScaleIn {
+		action = policies.ActionOpts{
+			Operation:   "REMOVE",
+			InstanceNum: d.Get("scale_in_instance_number").(int),
+		}
+	}
+
+	createOpts := policies.CreateOpts{
+		Name:         fmt.Sprintf("%s_%s", d.Get("name").(string), direction),
+		ID:           d.Get("scaling_group_id").(string),
+		Type:         "ALARM",
+		AlarmID:      alarmId,
+		Action:       action,
+		CoolDownTime: d.Get("cool_down_time").(int),
+	}
+
+	log.Printf("[DEBUG] Create AS policy options of target tracking policy: %#v", createOpts)
+	policyId, err := policies.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("error creating the %s AS policy: %s", direction, err)
+	}
+	return policyId, nil
+}
+
+// getTargetTrackingAlarm returns the alarm rule of the policy, nil is returned if the alarm rule has been deleted.
+func getTargetTrackingAlarm(client *golangsdk.ServiceClient, alarmId string) (*alarmrule.AlarmRule, error) {
+	var r struct {
+		Alarms []alarmrule.AlarmRule `json:"alarms"`
+	}
+	err := alarmrule.Get(client, alarmId).ExtractInto(&r)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(r.Alarms) < 1 {
+		return nil, nil
+	}
+	return &r.Alarms[0], nil
+}
+
+// resourceASTargetTrackingPolicyCustomizeDiff plans to recreate the alarm rules which have been deleted outside.
+func resourceASTargetTrackingPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, direction := range []string{targetTrackingScaleOut, targetTrackingScaleIn} {
+		if d.Get(direction+"_policy_id").(string) != "" && d.Get(direction+"_alarm_id").(string) == "" {
+			if err := d.SetNewComputed(direction + "_alarm_id"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceASTargetTrackingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Cloud Eye Service v2 client: %s", err)
+	}
+
+	if getTargetTrackingScaleInThreshold(d) >= d.Get("target_value").(float64) {
+		return diag.Errorf("`scale_in_threshold` must be less than `target_value`")
+	}
+
+	directions := []string{targetTrackingScaleOut}
+	if !d.Get("disable_scale_in").(bool) {
+		directions = append(directions, targetTrackingScaleIn)
+	}
+
+	for _, direction := range directions {
+		alarmId, err := createTargetTrackingAlarm(cesClient, d, direction)
+		if err != nil {
+			return diag.Errorf("error creating AS target tracking policy: %s", err)
+		}
+
+		policyId, err := createTargetTrackingPolicy(asClient, d, direction, alarmId)
+		if err != nil {
+			// Clean up the alarm rule which is not used by any policy.
+			deleteOpts := alarmrule.DeleteOpts{
+				AlarmIDs: []string{alarmId},
+			}
+			if delErr := alarmrule.Delete(cesClient, deleteOpts).ExtractErr(); delErr != nil {
+				log.Printf("[WARN] error deleting the %s alarm rule (%s): %s", direction, alarmId, delErr)
+			}
+			return diag.Errorf("error creating AS target tracking policy: %s", err)
+		}
+		if direction == targetTrackingScaleOut {
+			d.SetId(policyId)
+		}
+
+		mErr := multierror.Append(nil,
+			d.Set(direction+"_alarm_id", alarmId),
+			d.Set(direction+"_policy_id", policyId),
+		)
+		if err = mErr.ErrorOrNil(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceASTargetTrackingPolicyRead(ctx, d, meta)
+}
+
+func resourceASTargetTrackingPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Cloud Eye Service v2 client: %s", err)
+	}
+
+	scaleOutPolicy, err := policies.Get(asClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "AS target tracking policy")
+	}
+	log.Printf("[DEBUG] Retrieved scale out policy of AS target tracking policy %s: %+v", d.Id(), scaleOutPolicy)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("scaling_group_id", scaleOutPolicy.ID),
+		d.Set("cool_down_time", scaleOutPolicy.CoolDownTime),
+		d.Set("scale_out_instance_number", scaleOutPolicy.Action.InstanceNum),
+		d.Set("scale_out_policy_id", d.Id()),
+		d.Set("scale_out_alarm_id", scaleOutPolicy.AlarmID),
+	)
+
+	scaleOutAlarm, err := getTargetTrackingAlarm(cesClient, scaleOutPolicy.AlarmID)
+	if err != nil {
+		return diag.Errorf("error retrieving the scale out alarm rule (%s): %s", scaleOutPolicy.AlarmID, err)
+	}
+	if scaleOutAlarm == nil {
+		log.Printf("[WARN] the scale out alarm rule (%s) has been deleted, it will be recreated",
+			scaleOutPolicy.AlarmID)
+		mErr = multierror.Append(mErr, d.Set("scale_out_alarm_id", ""))
+	} else if len(scaleOutAlarm.Policies) > 0 {
+		alarmPolicy := scaleOutAlarm.Policies[0]
+		mErr = multierror.Append(mErr,
+			d.Set("metric_name", alarmPolicy.MetricName),
+			d.Set("target_value", alarmPolicy.Value),
+			d.Set("period", alarmPolicy.Period),
+			d.Set("evaluation_periods", alarmPolicy.Count),
+		)
+	}
+
+	if scaleInPolicyId := d.Get("scale_in_policy_id").(string); scaleInPolicyId != "" {
+		scaleInPolicy, err := policies.Get(asClient, scaleInPolicyId).Extract()
+		if err != nil {
+			return diag.Errorf("error retrieving the scale in policy (%s): %s", scaleInPolicyId, err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("scale_in_instance_number", scaleInPolicy.Action.InstanceNum),
+			d.Set("scale_in_alarm_id", scaleInPolicy.AlarmID),
+		)
+
+		scaleInAlarm, err := getTargetTrackingAlarm(cesClient, scaleInPolicy.AlarmID)
+		if err != nil {
+			return diag.Errorf("error retrieving the scale in alarm rule (%s): %s", scaleInPolicy.AlarmID, err)
+		}
+		if scaleInAlarm == nil {
+			log.Printf("[WARN] the scale in alarm rule (%s) has been deleted, it will be recreated",
+				scaleInPolicy.AlarmID)
+			mErr = multierror.Append(mErr, d.Set("scale_in_alarm_id", ""))
+		} else if _, ok := d.GetOk("scale_in_threshold"); ok && len(scaleInAlarm.Policies) > 0 {
+			// The default threshold is calculated from the target value, so it is only refreshed when it is specified.
+			mErr = multierror.Append(mErr, d.Set("scale_in_threshold", scaleInAlarm.Policies[0].Value))
+		}
+	}
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceASTargetTrackingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Cloud Eye Service v2 client: %s", err)
+	}
+
+	if getTargetTrackingScaleInThreshold(d) >= d.Get("target_value").(float64) {
+		return diag.Errorf("`scale_in_threshold` must be less than `target_value`")
+	}
+
+	directions := []string{targetTrackingScaleOut}
+	if d.Get("scale_in_policy_id").(string) != "" {
+		directions = append(directions, targetTrackingScaleIn)
+	}
+
+	for _, direction := range directions {
+		alarmId := d.Get(direction + "_alarm_id").(string)
+		isAlarmRecreated := alarmId == ""
+		if isAlarmRecreated {
+			// The alarm rule has been deleted outside, create a new one and bind it to the policy.
+			alarmId, err = createTargetTrackingAlarm(cesClient, d, direction)
+			if err != nil {
+				return diag.Errorf("error recreating the alarm rule of AS target tracking policy: %s", err)
+			}
+		} else if d.HasChanges("metric_name", "target_value", "scale_in_threshold", "period", "evaluation_periods") {
+			updateOpts := alarmrule.UpdatePoliciesOpts{
+				Policies: buildTargetTrackingAlarmPolicies(d, direction),
+			}
+			if err := alarmrule.PoliciesModify(cesClient, alarmId, updateOpts).ExtractErr(); err != nil {
+				return diag.Errorf("error updating the %s alarm rule (%s): %s", direction, alarmId, err)
+			}
+		}
+
+		if isAlarmRecreated || d.HasChanges("cool_down_time", direction+"_instance_number") {
+			policyId := d.Get(direction + "_policy_id").(string)
+			operation := "ADD"
+			if direction == targetTrackingScaleIn {
+				operation = "REMOVE"
+			}
+			updateOpts := policies.UpdateOpts{
+				Name:    fmt.Sprintf("%s_%s", d.Get("name").(string), direction),
+				Type:    "ALARM",
+				AlarmID: alarmId,
+				Action: policies.ActionOpts{
+					Operation:   operation,
+					InstanceNum: d.Get(direction + "_instance_number").(int),
+				},
+				CoolDownTime: d.Get("cool_down_time").(int),
+			}
+			if _, err := policies.Update(asClient, policyId, updateOpts).Extract(); err != nil {
+				if isAlarmRecreated {
+					// Clean up the alarm rule which is not used by any policy.
+					deleteOpts := alarmrule.DeleteOpts{
+						AlarmIDs: []string{alarmId},
+					}
+					if delErr := alarmrule.Delete(cesClient, deleteOpts).ExtractErr(); delErr != nil {
+						log.Printf("[WARN] error deleting the %s alarm rule (%s): %s", direction, alarmId, delErr)
+					}
+				}
+				return diag.Errorf("error updating the %s AS policy (%s): %s", direction, policyId, err)
+			}
+		}
+	}
+
+	return resourceASTargetTrackingPolicyRead(ctx, d, meta)
+}
+
+func resourceASTargetTrackingPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Cloud Eye Service v2 client: %s", err)
+	}
+
+	// The policies must be deleted before the alarm rules which they are using.
+	alarmIds := make([]string, 0, 2)
+	for _, direction := range []string{targetTrackingScaleOut, targetTrackingScaleIn} {
+		if policyId := d.Get(direction + "_policy_id").(string); policyId != "" {
+			if err := policies.Delete(asClient, policyId).ExtractErr(); err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); !ok {
+					return diag.Errorf("error deleting the %s AS policy (%s): %s", direction, policyId, err)
+				}
+			}
+		}
+		if alarmId := d.Get(direction + "_alarm_id").(string); alarmId != "" {
+			alarmIds = append(alarmIds, alarmId)
+		}
+	}
+
+	if len(alarmIds) > 0 {
+		deleteOpts := alarmrule.DeleteOpts{
+			AlarmIDs: alarmIds,
+		}
+		if err := alarmrule.Delete(cesClient, deleteOpts).ExtractErr(); err != nil {
+			return diag.Errorf("error deleting the alarm rules (%v) of AS target tracking policy: %s", alarmIds, err)
+		}
+	}
+
+	return nil
+}
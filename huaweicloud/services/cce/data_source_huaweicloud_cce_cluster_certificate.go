@@ -3,6 +3,7 @@ package cce
 import (
 	"context"
 	"log"
+	"sort"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-uuid"
@@ -34,10 +35,47 @@ func DataSourceCCEClusterCertificate() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"exec_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"env": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "exec-user",
+						},
+						"api_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "client.authentication.k8s.io/v1beta1",
+						},
+					},
+				},
+			},
 			"kube_config_raw": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"exec_kube_config_raw": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"clusters": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -149,8 +187,16 @@ func dataSourceCCEClusterCertificateRead(_ context.Context, d *schema.ResourceDa
 		d.Set("contexts", flattenClusterCertContexts(cert)),
 	)
 
+	if execCredential, ok := d.GetOk("exec_credential"); ok {
+		execKubeConfigRaw, err := utils.JsonMarshal(buildExecKubeConfig(cert, execCredential.([]interface{})))
+		if err != nil {
+			return diag.Errorf("error building the kubeconfig in exec-plugin form: %s", err)
+		}
+		mErr = multierror.Append(mErr, d.Set("exec_kube_config_raw", string(execKubeConfigRaw)))
+	}
+
 	if mErr.ErrorOrNil() != nil {
-		return diag.Errorf("error setting CCE clusters certificate: %s", err)
+		return diag.Errorf("error setting CCE clusters certificate: %s", mErr)
 	}
 
 	return nil
@@ -195,3 +241,70 @@ func flattenClusterCertContexts(cert *clusters.Certificate) []map[string]interfa
 	}
 	return res
 }
+
+// buildExecKubeConfig builds a kubeconfig without the embedded client certificate and key, the users of which obtain
+// short-lived tokens through the specified exec credential plugin.
+func buildExecKubeConfig(cert *clusters.Certificate, execCredentials []interface{}) map[string]interface{} {
+	execCredential := execCredentials[0].(map[string]interface{})
+	userName := execCredential["user_name"].(string)
+
+	envRaw := execCredential["env"].(map[string]interface{})
+	envNames := make([]string, 0, len(envRaw))
+	for k := range envRaw {
+		envNames = append(envNames, k)
+	}
+	sort.Strings(envNames)
+	envs := make([]map[string]interface{}, 0, len(envNames))
+	for _, k := range envNames {
+		envs = append(envs, map[string]interface{}{
+			"name":  k,
+			"value": envRaw[k],
+		})
+	}
+
+	certClusters := make([]map[string]interface{}, len(cert.Clusters))
+	for i, cluster := range cert.Clusters {
+		certClusters[i] = map[string]interface{}{
+			"name": cluster.Name,
+			"cluster": map[string]interface{}{
+				"server":                     cluster.Cluster.Server,
+				"certificate-authority-data": cluster.Cluster.CertAuthorityData,
+				"insecure-skip-tls-verify":   cluster.Cluster.InsecureSkipTLSVerify,
+			},
+		}
+	}
+
+	contexts := make([]map[string]interface{}, len(cert.Contexts))
+	for i, c := range cert.Contexts {
+		contexts[i] = map[string]interface{}{
+			"name": c.Name,
+			"context": map[string]interface{}{
+				"cluster": c.Context.Cluster,
+				"user":    userName,
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"kind":        "Config",
+		"apiVersion":  "v1",
+		"preferences": map[string]interface{}{},
+		"clusters":    certClusters,
+		"users": []map[string]interface{}{
+			{
+				"name": userName,
+				"user": map[string]interface{}{
+					"exec": map[string]interface{}{
+						"apiVersion":      execCredential["api_version"],
+						"command":         execCredential["command"],
+						"args":            utils.ExpandToStringList(execCredential["args"].([]interface{})),
+						"env":             envs,
+						"interactiveMode": "Never",
+					},
+				},
+			},
+		},
+		"contexts":        contexts,
+		"current-context": cert.CurrentContext,
+	}
+}
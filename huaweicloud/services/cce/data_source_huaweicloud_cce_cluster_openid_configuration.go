@@ -0,0 +1,103 @@
+package cce
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API CCE GET /.well-known/openid-configuration
+// @API CCE GET /openid/v1/jwks
+func DataSourceClusterOpenIDConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterOpenIDConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id_token_signing_alg_values_supported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func getClusterOpenIDResource(client *golangsdk.ServiceClient, clusterId, path string) (interface{}, error) {
+	baseUrl, err := buildClusterApiServerURL(client, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", baseUrl+path, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func dataSourceClusterOpenIDConfigurationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	configuration, err := getClusterOpenIDResource(client, clusterId, ".well-known/openid-configuration")
+	if err != nil {
+		return diag.Errorf("error retrieving the OpenID configuration of CCE cluster (%s): %s", clusterId, err)
+	}
+	jwks, err := getClusterOpenIDResource(client, clusterId, "openid/v1/jwks")
+	if err != nil {
+		return diag.Errorf("error retrieving the JWKS of CCE cluster (%s): %s", clusterId, err)
+	}
+	signingKey, err := utils.JsonMarshal(jwks)
+	if err != nil {
+		return diag.Errorf("error marshaling the JWKS of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	d.SetId(clusterId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("issuer", utils.PathSearch("issuer", configuration, nil)),
+		d.Set("jwks_uri", utils.PathSearch("jwks_uri", configuration, nil)),
+		d.Set("signing_key", string(signingKey)),
+		d.Set("id_token_signing_alg_values_supported",
+			utils.PathSearch("id_token_signing_alg_values_supported", configuration, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting the OpenID configuration of CCE cluster: %s", err)
+	}
+	return nil
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/templates"
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
@@ -37,6 +41,8 @@ func ResourceAddon() *schema.Resource {
 			StateContext: resourceAddonImport,
 		},
 
+		CustomizeDiff: resourceAddonCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -122,6 +128,15 @@ func ResourceAddon() *schema.Resource {
 					},
 				},
 			},
+			"merge_template_defaults": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"flattened_values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -134,45 +149,514 @@ func ResourceAddon() *schema.Resource {
 	}
 }
 
-func buildAddonValues(d *schema.ResourceData) (basic, custom, flavor map[string]interface{}, err error) {
-	values := d.Get("values").([]interface{})
-	if len(values) == 0 || values[0] == nil {
-		basic = make(map[string]interface{})
-		return
+// getAddonTemplateInput returns the installing parameters of the add-on template of the specified version, which
+// includes the default values of basic and parameters (custom and flavors), and the optional JSON schema.
+func getAddonTemplateInput(client *golangsdk.ServiceClient, clusterId, name, version string) (map[string]interface{},
+	error) {
+	templateList, err := templates.List(client, clusterId).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve template list: %s", err)
 	}
 
-	valuesMap := values[0].(map[string]interface{})
-	if basicRaw := valuesMap["basic"].(map[string]interface{}); len(basicRaw) != 0 {
-		basic = basicRaw
-	} else if basicJsonRaw := valuesMap["basic_json"].(string); basicJsonRaw != "" {
-		err = json.Unmarshal([]byte(basicJsonRaw), &basic)
-		if err != nil {
-			err = fmt.Errorf("error unmarshalling basic json: %s", err)
-			return
+	for _, temp := range templateList {
+		if temp.Metadata.Name != name {
+			continue
+		}
+		for _, ver := range temp.Spec.Versions {
+			if ver.Version == version {
+				return ver.Input, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unable to find the %s template of version %s", name, version)
+}
+
+// getAddonTemplateDefaults returns the default values of each part (basic, custom and flavor) of the template.
+func getAddonTemplateDefaults(input map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"basic":  utils.PathSearch("basic", input, nil),
+		"custom": utils.PathSearch("parameters.custom", input, nil),
+		"flavor": utils.PathSearch("parameters.flavor1", input, nil),
+	}
+	for k, v := range result {
+		if _, ok := v.(map[string]interface{}); !ok {
+			result[k] = make(map[string]interface{})
+		}
+	}
+	return result
+}
+
+// convertAddonMapValue converts the string value of the map to the type of the template default value.
+func convertAddonMapValue(value string, defaultValue interface{}) (interface{}, error) {
+	switch defaultValue.(type) {
+	case bool:
+		return strconv.ParseBool(value)
+	case float64:
+		return strconv.ParseFloat(value, 64)
+	case map[string]interface{}, []interface{}:
+		var result interface{}
+		err := json.Unmarshal([]byte(value), &result)
+		return result, err
+	}
+	return value, nil
+}
+
+// mergeAddonMapValues merges the map values over the template defaults. The keys of the map are the paths of the
+// fields separated by dots, and the values are converted to the types of the template defaults.
+func mergeAddonMapValues(raw, defaults map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if copied, err := normalizeManifestValue(defaults); err == nil {
+		if m, ok := copied.(map[string]interface{}); ok {
+			result = m
 		}
 	}
 
-	if customRaw := valuesMap["custom"].(map[string]interface{}); len(customRaw) != 0 {
-		custom = customRaw
-	} else if customJsonRaw := valuesMap["custom_json"].(string); customJsonRaw != "" {
-		err = json.Unmarshal([]byte(customJsonRaw), &custom)
+	for path, v := range raw {
+		keys := strings.Split(path, ".")
+		current := result
+		var defaultValue interface{} = defaults
+		for _, key := range keys[:len(keys)-1] {
+			defaultValue = utils.PathSearch(fmt.Sprintf("\"%s\"", key), defaultValue, nil)
+			next, ok := current[key].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[key] = next
+			}
+			current = next
+		}
+		lastKey := keys[len(keys)-1]
+		defaultValue = utils.PathSearch(fmt.Sprintf("\"%s\"", lastKey), defaultValue, nil)
+
+		value, err := convertAddonMapValue(v.(string), defaultValue)
 		if err != nil {
-			err = fmt.Errorf("error unmarshalling custom json: %s", err)
-			return
+			return nil, fmt.Errorf("the value of %s is invalid, want the same type as the default value (%v): %s",
+				path, defaultValue, err)
+		}
+		current[lastKey] = value
+	}
+	return result, nil
+}
+
+// mergeAddonJsonValues merges the JSON values over the template defaults recursively.
+func mergeAddonJsonValues(raw, defaults map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range raw {
+		rawChild, isRawMap := v.(map[string]interface{})
+		defaultChild, isDefaultMap := result[k].(map[string]interface{})
+		if isRawMap && isDefaultMap {
+			result[k] = mergeAddonJsonValues(rawChild, defaultChild)
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// buildAddonValues builds the basic, custom and flavor values of the add-on. If the template input is specified, the
+// values are merged over the template defaults, and the map values are converted to the types of the defaults.
+func buildAddonValues(rawValues []interface{}, input map[string]interface{}) (map[string]map[string]interface{},
+	error) {
+	result := map[string]map[string]interface{}{
+		"basic": make(map[string]interface{}),
+	}
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return result, nil
+	}
+
+	valuesMap := rawValues[0].(map[string]interface{})
+	defaults := getAddonTemplateDefaults(input)
+	for _, part := range []string{"basic", "custom", "flavor"} {
+		if raw, ok := valuesMap[part].(map[string]interface{}); ok && len(raw) != 0 {
+			if input == nil {
+				result[part] = raw
+				continue
+			}
+			merged, err := mergeAddonMapValues(raw, defaults[part].(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("error building %s values: %s", part, err)
+			}
+			result[part] = merged
+		} else if jsonRaw, ok := valuesMap[part+"_json"].(string); ok && jsonRaw != "" {
+			var parsed map[string]interface{}
+			if err := json.Unmarshal([]byte(jsonRaw), &parsed); err != nil {
+				return nil, fmt.Errorf("error unmarshalling %s json: %s", part, err)
+			}
+			if input == nil {
+				result[part] = parsed
+				continue
+			}
+			result[part] = mergeAddonJsonValues(parsed, defaults[part].(map[string]interface{}))
+		} else if part == "basic" && input != nil {
+			// The basic values of the template are required for installation.
+			result[part] = defaults[part].(map[string]interface{})
+		}
+	}
+	return result, nil
+}
+
+// getAddonUserValues returns the values specified by the user, without the template defaults.
+func getAddonUserValues(rawValues []interface{}, input map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if len(rawValues) == 0 || rawValues[0] == nil {
+		return result, nil
+	}
+
+	defaults := getAddonTemplateDefaults(input)
+	valuesMap := rawValues[0].(map[string]interface{})
+	for _, part := range []string{"basic", "custom", "flavor"} {
+		if raw, ok := valuesMap[part].(map[string]interface{}); ok && len(raw) != 0 {
+			// The template defaults are used to convert the types of the values, and then they are removed.
+			merged, err := mergeAddonMapValues(raw, defaults[part].(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("error building %s values: %s", part, err)
+			}
+			result[part] = filterAddonValues(merged, raw)
+		} else if jsonRaw, ok := valuesMap[part+"_json"].(string); ok && jsonRaw != "" {
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(jsonRaw), &parsed); err != nil {
+				return nil, fmt.Errorf("error unmarshalling %s json: %s", part, err)
+			}
+			result[part] = parsed
+		}
+	}
+	return result, nil
+}
+
+// filterAddonValues only keeps the fields whose paths are the keys of the raw map.
+func filterAddonValues(values map[string]interface{}, raw map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for path := range raw {
+		keys := strings.Split(path, ".")
+		var value interface{} = values
+		current := result
+		for i, key := range keys {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				break
+			}
+			value = m[key]
+			if i == len(keys)-1 {
+				current[key] = value
+				break
+			}
+			next, ok := current[key].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[key] = next
+			}
+			current = next
+		}
+	}
+	return result
+}
+
+// flattenAddonValues flattens the values to a map whose keys are the paths of the fields separated by dots.
+// The string values are kept and the other values are converted to JSON strings, so the change of each field can be
+// shown in the plan.
+func flattenAddonValues(prefix string, value interface{}, result map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenAddonValues(path, child, result)
+		}
+	case string:
+		result[prefix] = v
+	default:
+		b, _ := json.Marshal(v)
+		result[prefix] = string(b)
+	}
+}
+
+// flattenAddonServerValues flattens the fields of the server values which are specified by the user values.
+func flattenAddonServerValues(userValues map[string]interface{}, serverValues map[string]interface{}) map[string]interface{} {
+	userFields := make(map[string]interface{})
+	flattenAddonValues("", userValues, userFields)
+	serverFields := make(map[string]interface{})
+	flattenAddonValues("", serverValues, serverFields)
+
+	result := make(map[string]interface{})
+	for path := range userFields {
+		if v, ok := serverFields[path]; ok {
+			result[path] = v
+		}
+	}
+	return result
+}
+
+// validateAddonValueTypes checks the types of the values against the template defaults. The scalar values are
+// allowed to be strings, because the template defaults of some add-ons are not typed strictly.
+func validateAddonValueTypes(path string, value, defaultValue interface{}) []error {
+	if value == nil || defaultValue == nil {
+		return nil
+	}
+
+	switch defaultVal := defaultValue.(type) {
+	case map[string]interface{}:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{fmt.Errorf("%s: want an object, but got %v", path, value)}
+		}
+		var errs []error
+		for k, v := range m {
+			errs = append(errs, validateAddonValueTypes(path+"."+k, v, defaultVal[k])...)
+		}
+		return errs
+	case []interface{}:
+		if _, ok := value.([]interface{}); !ok {
+			return []error{fmt.Errorf("%s: want an array, but got %v", path, value)}
+		}
+	case bool:
+		if str, ok := value.(string); ok {
+			if _, err := strconv.ParseBool(str); err != nil {
+				return []error{fmt.Errorf("%s: want a boolean, but got %q", path, str)}
+			}
+		} else if _, ok := value.(bool); !ok {
+			return []error{fmt.Errorf("%s: want a boolean, but got %v", path, value)}
+		}
+	case float64:
+		if str, ok := value.(string); ok {
+			if _, err := strconv.ParseFloat(str, 64); err != nil {
+				return []error{fmt.Errorf("%s: want a number, but got %q", path, str)}
+			}
+		} else if _, ok := value.(float64); !ok {
+			return []error{fmt.Errorf("%s: want a number, but got %v", path, value)}
+		}
+	default:
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return []error{fmt.Errorf("%s: want a scalar value, but got %v", path, value)}
+		}
+	}
+	return nil
+}
+
+func isJsonSchemaTypeMatched(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		v, ok := value.(float64)
+		return ok && v == float64(int64(v))
+	case "null":
+		return value == nil
+	}
+	return true
+}
+
+// validateJsonSchemaValue validates the value against the JSON schema, the commonly used keywords are supported.
+func validateJsonSchemaValue(path string, value interface{}, jsonSchema map[string]interface{}) []error {
+	if rawType, ok := jsonSchema["type"]; ok {
+		types := make([]string, 0)
+		switch t := rawType.(type) {
+		case string:
+			types = append(types, t)
+		case []interface{}:
+			for _, v := range t {
+				types = append(types, fmt.Sprint(v))
+			}
+		}
+		matched := len(types) == 0
+		for _, t := range types {
+			if isJsonSchemaTypeMatched(value, t) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return []error{fmt.Errorf("%s: want the type %v, but got %v", path, rawType, value)}
+		}
+	}
+
+	var errs []error
+	if enum, ok := jsonSchema["enum"].([]interface{}); ok {
+		found := false
+		for _, v := range enum {
+			if reflect.DeepEqual(v, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Errorf("%s: want one of %v, but got %v", path, enum, value))
 		}
 	}
+	if number, ok := value.(float64); ok {
+		if minimum, ok := jsonSchema["minimum"].(float64); ok && number < minimum {
+			errs = append(errs, fmt.Errorf("%s: want at least %v, but got %v", path, minimum, number))
+		}
+		if maximum, ok := jsonSchema["maximum"].(float64); ok && number > maximum {
+			errs = append(errs, fmt.Errorf("%s: want at most %v, but got %v", path, maximum, number))
+		}
+	}
+	if str, ok := value.(string); ok {
+		if pattern, ok := jsonSchema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(str) {
+				errs = append(errs, fmt.Errorf("%s: %q does not match the pattern %s", path, str, pattern))
+			}
+		}
+	}
+	if items, ok := jsonSchema["items"].(map[string]interface{}); ok {
+		if list, ok := value.([]interface{}); ok {
+			for i, v := range list {
+				errs = append(errs, validateJsonSchemaValue(fmt.Sprintf("%s[%d]", path, i), v, items)...)
+			}
+		}
+	}
+	if obj, ok := value.(map[string]interface{}); ok {
+		properties, _ := jsonSchema["properties"].(map[string]interface{})
+		if required, ok := jsonSchema["required"].([]interface{}); ok {
+			for _, key := range required {
+				if _, ok := obj[fmt.Sprint(key)]; !ok {
+					errs = append(errs, fmt.Errorf("%s: the field %v is required", path, key))
+				}
+			}
+		}
+		for k, v := range obj {
+			if propertySchema, ok := properties[k].(map[string]interface{}); ok {
+				errs = append(errs, validateJsonSchemaValue(path+"."+k, v, propertySchema)...)
+			} else if additional, ok := jsonSchema["additionalProperties"].(bool); ok && !additional {
+				errs = append(errs, fmt.Errorf("%s: the field %s is not supported", path, k))
+			}
+		}
+	}
+	return errs
+}
 
-	if flavorRaw := valuesMap["flavor"].(map[string]interface{}); len(flavorRaw) != 0 {
-		flavor = flavorRaw
-	} else if flavorJsonRaw := valuesMap["flavor_json"].(string); flavorJsonRaw != "" {
-		err = json.Unmarshal([]byte(flavorJsonRaw), &flavor)
+// validateAddonValues validates the values against the JSON schema of the template. If the template does not
+// provide the schema, the values are checked against the types of the template defaults.
+func validateAddonValues(values map[string]map[string]interface{}, input map[string]interface{}) error {
+	var errs []error
+	if jsonSchema, ok := input["schema"].(map[string]interface{}); ok {
+		valuesObj := make(map[string]interface{})
+		for part, v := range values {
+			valuesObj[part] = v
+		}
+		if normalized, err := normalizeManifestValue(valuesObj); err == nil {
+			errs = validateJsonSchemaValue("values", normalized, jsonSchema)
+		}
+	} else {
+		defaults := getAddonTemplateDefaults(input)
+		for part, v := range values {
+			errs = append(errs, validateAddonValueTypes("values."+part, map[string]interface{}(v), defaults[part])...)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	mErr := multierror.Append(nil, errs...)
+	return fmt.Errorf("the add-on values are invalid for the template: %s", mErr)
+}
+
+func resourceAddonCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The fields of the new values are only known after the add-on is installed or updated, because the add-on may
+	// not return all the fields specified by the user.
+	if d.Id() == "" || d.HasChanges("values", "version", "merge_template_defaults") {
+		if err := validateAddonValuesWithTemplate(d, meta); err != nil {
+			return err
+		}
+		return d.SetNewComputed("flattened_values")
+	}
+
+	// Only the fields which are specified by the user and returned by the add-on are compared, so the drifts of each
+	// field are shown in the plan.
+	userValues, err := getAddonUserValues(d.Get("values").([]interface{}), nil)
+	if err != nil {
+		return err
+	}
+	userFields := make(map[string]interface{})
+	flattenAddonValues("", userValues, userFields)
+
+	stateFields := d.Get("flattened_values").(map[string]interface{})
+	flattened := make(map[string]interface{})
+	for path, v := range userFields {
+		if _, ok := stateFields[path]; ok {
+			flattened[path] = v
+		}
+	}
+	if !reflect.DeepEqual(flattened, stateFields) {
+		return d.SetNew("flattened_values", flattened)
+	}
+	return nil
+}
+
+// validateAddonValuesWithTemplate validates the values against the add-on template, the template is only fetched
+// when the values or the version are changed and merge_template_defaults is enabled. The values which are sent as
+// they are keep being validated by the add-on during the installation.
+func validateAddonValuesWithTemplate(d *schema.ResourceDiff, meta interface{}) error {
+	version := d.Get("version").(string)
+	if !d.NewValueKnown("merge_template_defaults") || !d.Get("merge_template_defaults").(bool) ||
+		!d.NewValueKnown("values") || !d.NewValueKnown("cluster_id") || !d.NewValueKnown("version") ||
+		version == "" {
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := cfg.CceAddonV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating CCE v3 Client (without project): %s", err)
+	}
+	input, err := getAddonTemplateInput(client, d.Get("cluster_id").(string), d.Get("template_name").(string),
+		version)
+	if err != nil {
+		return err
+	}
+
+	// The values are merged over the template defaults before the validation, as they are sent to the add-on.
+	values, err := buildAddonValues(d.Get("values").([]interface{}), input)
+	if err != nil {
+		return err
+	}
+	return validateAddonValues(values, input)
+}
+
+// buildAddonValuesWithTemplate builds the add-on values. The map values are merged over the template defaults only
+// when merge_template_defaults is enabled, otherwise the values are sent as they are.
+func buildAddonValuesWithTemplate(client *golangsdk.ServiceClient, d *schema.ResourceData) (addons.Values, error) {
+	var input map[string]interface{}
+	rawValues := d.Get("values").([]interface{})
+	if version := d.Get("version").(string); version != "" && d.Get("merge_template_defaults").(bool) {
+		var err error
+		input, err = getAddonTemplateInput(client, d.Get("cluster_id").(string), d.Get("template_name").(string),
+			version)
 		if err != nil {
-			err = fmt.Errorf("error unmarshalling flavor json %s", err)
-			return
+			return addons.Values{}, err
 		}
 	}
 
-	return
+	values, err := buildAddonValues(rawValues, input)
+	if err != nil {
+		return addons.Values{}, err
+	}
+	return addons.Values{
+		Basic:  values["basic"],
+		Custom: values["custom"],
+		Flavor: values["flavor"],
+	}, nil
 }
 
 func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -183,7 +667,7 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	clusterID := d.Get("cluster_id").(string)
-	basic, custom, flavor, err := buildAddonValues(d)
+	values, err := buildAddonValuesWithTemplate(cceClient, d)
 	if err != nil {
 		return diag.Errorf("error getting values for CCE add-on: %s", err)
 	}
@@ -200,11 +684,7 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			Version:           d.Get("version").(string),
 			ClusterID:         clusterID,
 			AddonTemplateName: d.Get("template_name").(string),
-			Values: addons.Values{
-				Basic:  basic,
-				Custom: custom,
-				Flavor: flavor,
-			},
+			Values:            values,
 		},
 	}
 
@@ -248,9 +728,24 @@ func resourceAddonRead(_ context.Context, d *schema.ResourceData, meta interface
 		return common.CheckDeletedDiag(d, err, "error retrieving CCE add-on")
 	}
 
+	// Only the fields specified by the user are saved, the template defaults are ignored.
+	userValues, err := getAddonUserValues(d.Get("values").([]interface{}), nil)
+	if err != nil {
+		log.Printf("[WARN] error parsing the values of CCE add-on (%s): %s", addonID, err)
+	}
+	serverValues := map[string]interface{}{
+		"basic":  n.Spec.Values.Basic,
+		"custom": n.Spec.Values.Custom,
+		"flavor": n.Spec.Values.Flavor,
+	}
+	if normalized, err := normalizeManifestValue(serverValues); err == nil {
+		serverValues, _ = normalized.(map[string]interface{})
+	}
+
 	mErr := multierror.Append(nil,
 		d.Set("region", cfg.GetRegion(d)),
 		d.Set("cluster_id", n.Spec.ClusterID),
+		d.Set("flattened_values", flattenAddonServerValues(userValues, serverValues)),
 		d.Set("version", n.Spec.Version),
 		d.Set("template_name", n.Spec.AddonTemplateName),
 		d.Set("status", n.Status.Status),
@@ -272,7 +767,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	clusterID := d.Get("cluster_id").(string)
 	addonID := d.Id()
-	basic, custom, flavor, err := buildAddonValues(d)
+	values, err := buildAddonValuesWithTemplate(cceClient, d)
 	if err != nil {
 		return diag.Errorf("error getting values for CCE add-on: %s", err)
 	}
@@ -289,11 +784,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			Version:           d.Get("version").(string),
 			ClusterID:         clusterID,
 			AddonTemplateName: d.Get("template_name").(string),
-			Values: addons.Values{
-				Basic:  basic,
-				Custom: custom,
-				Flavor: flavor,
-			},
+			Values:            values,
 		},
 	}
 
@@ -0,0 +1,397 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const autoscalerTemplateName = "autoscaler"
+
+// autoscalerCustomParams is the mapping between the schema fields and the custom values of the autoscaler add-on.
+var autoscalerCustomParams = map[string]string{
+	"expander":                              "expander",
+	"scale_up_unscheduled_pod_enabled":      "scaleUpUnscheduledPodEnabled",
+	"scale_up_utilization_enabled":          "scaleUpUtilizationEnabled",
+	"scale_up_cpu_utilization_threshold":    "scaleUpCpuUtilizationThreshold",
+	"scale_up_memory_utilization_threshold": "scaleUpMemUtilizationThreshold",
+	"scale_down_enabled":                    "scaleDownEnabled",
+	"scale_down_utilization_threshold":      "scaleDownUtilizationThreshold",
+	"scale_down_unneeded_time":              "scaleDownUnneededTime",
+	"scale_down_delay_after_add":            "scaleDownDelayAfterAdd",
+	"scale_down_delay_after_delete":         "scaleDownDelayAfterDelete",
+	"scale_down_delay_after_failure":        "scaleDownDelayAfterFailure",
+	"unremovable_node_recheck_timeout":      "unremovableNodeRecheckTimeout",
+	"max_empty_bulk_delete":                 "maxEmptyBulkDeleteFlag",
+	"max_nodes_total":                       "maxNodesTotal",
+	"cores_total":                           "coresTotal",
+	"memory_total":                          "memoryTotal",
+}
+
+// @API CCE GET /api/v3/addontemplates
+// @API CCE POST /api/v3/addons
+// @API CCE GET /api/v3/addons/{id}
+// @API CCE PUT /api/v3/addons/{id}
+// @API CCE DELETE /api/v3/addons/{id}
+func ResourceAutoscalerConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutoscalerConfigCreate,
+		ReadContext:   resourceAutoscalerConfigRead,
+		UpdateContext: resourceAutoscalerConfigUpdate,
+		DeleteContext: resourceAutoscalerConfigDelete,
+
+		CustomizeDiff: resourceAutoscalerConfigCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAddonImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expander": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"priority", "least-waste", "random", "most-pods", "priority,least-waste",
+				}, false),
+			},
+			"scale_up_unscheduled_pod_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"scale_up_utilization_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"scale_up_cpu_utilization_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"scale_up_memory_utilization_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"scale_down_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"scale_down_utilization_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"scale_down_unneeded_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+			"scale_down_delay_after_add": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"scale_down_delay_after_delete": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"scale_down_delay_after_failure": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1440),
+			},
+			"unremovable_node_recheck_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1440),
+			},
+			"max_empty_bulk_delete": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"max_nodes_total": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cores_total": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"memory_total": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAutoscalerConfigCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("scale_up_utilization_enabled").(bool) || !d.Get("scale_down_enabled").(bool) {
+		return nil
+	}
+
+	// The node should not be removed immediately after it is added by the utilization.
+	scaleDownThreshold := d.Get("scale_down_utilization_threshold").(float64)
+	for _, key := range []string{"scale_up_cpu_utilization_threshold", "scale_up_memory_utilization_threshold"} {
+		if v, ok := d.GetOk(key); ok && scaleDownThreshold >= v.(float64) {
+			return fmt.Errorf("scale_down_utilization_threshold must be less than %s", key)
+		}
+	}
+	return nil
+}
+
+func buildAutoscalerConfigValues(cfg *config.Config, d *schema.ResourceData, input map[string]interface{},
+	custom map[string]interface{}) addons.Values {
+	basic, _ := utils.PathSearch("basic", input, make(map[string]interface{})).(map[string]interface{})
+	flavor, _ := utils.PathSearch("parameters.flavor1", input, nil).(map[string]interface{})
+
+	result := make(map[string]interface{})
+	if defaultCustom, ok := utils.PathSearch("parameters.custom", input, nil).(map[string]interface{}); ok {
+		for k, v := range defaultCustom {
+			result[k] = v
+		}
+	}
+	// The current values are kept, so that the settings which are not specified are not reset to the defaults.
+	for k, v := range custom {
+		result[k] = v
+	}
+	for field, key := range autoscalerCustomParams {
+		// The false and zero values are also the valid settings, so the existence of the fields is checked.
+		// nolint:staticcheck
+		if v, ok := d.GetOkExists(field); ok {
+			result[key] = v
+		}
+	}
+	result["cluster_id"] = d.Get("cluster_id")
+	result["tenant_id"] = cfg.GetProjectID(cfg.GetRegion(d))
+
+	return addons.Values{
+		Basic:  basic,
+		Custom: result,
+		Flavor: flavor,
+	}
+}
+
+func waitForAutoscalerConfigAvailable(ctx context.Context, client *golangsdk.ServiceClient, addonId, clusterId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      addonStateRefreshFunc(client, addonId, clusterId, []string{"running", "available"}),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceAutoscalerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 Client (without project): %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	input, err := getAddonTemplateInput(client, clusterId, autoscalerTemplateName, d.Get("version").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOpts := addons.CreateOpts{
+		Kind:       "Addon",
+		ApiVersion: "v3",
+		Metadata: addons.CreateMetadata{
+			Anno: addons.CreateAnnotations{
+				AddonInstallType: "install",
+			},
+		},
+		Spec: addons.RequestSpec{
+			Version:           d.Get("version").(string),
+			ClusterID:         clusterId,
+			AddonTemplateName: autoscalerTemplateName,
+			Values:            buildAutoscalerConfigValues(cfg, d, input, nil),
+		},
+	}
+	create, err := addons.Create(client, createOpts, clusterId).Extract()
+	if err != nil {
+		return diag.Errorf("error creating CCE autoscaler config: %s", err)
+	}
+	d.SetId(create.Metadata.Id)
+
+	log.Printf("[DEBUG] Waiting for CCE autoscaler add-on (%s) to become available", d.Id())
+	err = waitForAutoscalerConfigAvailable(ctx, client, d.Id(), clusterId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for CCE autoscaler add-on (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAutoscalerConfigRead(ctx, d, meta)
+}
+
+func resourceAutoscalerConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceAddonV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 Client (without project): %s", err)
+	}
+
+	addon, err := addons.Get(client, d.Id(), d.Get("cluster_id").(string)).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE autoscaler config")
+	}
+	if addon.Spec.AddonTemplateName != autoscalerTemplateName {
+		return diag.Errorf("the add-on (%s) is not the %s add-on", d.Id(), autoscalerTemplateName)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", addon.Spec.ClusterID),
+		d.Set("version", addon.Spec.Version),
+		d.Set("status", addon.Status.Status),
+	)
+	for field, key := range autoscalerCustomParams {
+		if v, ok := addon.Spec.Values.Custom[key]; ok {
+			mErr = multierror.Append(mErr, d.Set(field, v))
+		}
+	}
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceAutoscalerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 Client (without project): %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	addon, err := addons.Get(client, d.Id(), clusterId).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving CCE autoscaler config (%s): %s", d.Id(), err)
+	}
+	input, err := getAddonTemplateInput(client, clusterId, autoscalerTemplateName, d.Get("version").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := buildAutoscalerConfigValues(cfg, d, input, addon.Spec.Values.Custom)
+	// Keep the current flavor, which may be changed by the users in the console.
+	if len(addon.Spec.Values.Flavor) != 0 {
+		values.Flavor = addon.Spec.Values.Flavor
+	}
+	updateOpts := addons.UpdateOpts{
+		Kind:       "Addon",
+		ApiVersion: "v3",
+		Metadata: addons.UpdateMetadata{
+			Anno: addons.UpdateAnnotations{
+				AddonUpgradeType: "upgrade",
+			},
+		},
+		Spec: addons.RequestSpec{
+			Version:           d.Get("version").(string),
+			ClusterID:         clusterId,
+			AddonTemplateName: autoscalerTemplateName,
+			Values:            values,
+		},
+	}
+	_, err = addons.Update(client, updateOpts, d.Id(), clusterId).Extract()
+	if err != nil {
+		return diag.Errorf("error updating CCE autoscaler config (%s): %s", d.Id(), err)
+	}
+
+	err = waitForAutoscalerConfigAvailable(ctx, client, d.Id(), clusterId, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("error waiting for CCE autoscaler add-on (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAutoscalerConfigRead(ctx, d, meta)
+}
+
+func resourceAutoscalerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceAddonV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 Client (without project): %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	err = addons.Delete(client, d.Id(), clusterId).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE autoscaler config")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      addonStateRefreshFunc(client, d.Id(), clusterId, nil),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CCE autoscaler add-on (%s) to become deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,410 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The backup plans are saved as the Velero objects in the cluster, which are handled by the e-backup add-on.
+const (
+	backupApiVersion              = "velero.io/v1"
+	backupNamespacesHttpUrl       = "apis/velero.io/v1/namespaces/velero/"
+	backupScheduleResource        = "schedules"
+	backupStorageLocationResource = "backupstoragelocations"
+)
+
+// @API CCE POST /apis/velero.io/v1/namespaces/velero/backupstoragelocations
+// @API CCE GET /apis/velero.io/v1/namespaces/velero/backupstoragelocations/{name}
+// @API CCE PATCH /apis/velero.io/v1/namespaces/velero/backupstoragelocations/{name}
+// @API CCE DELETE /apis/velero.io/v1/namespaces/velero/backupstoragelocations/{name}
+// @API CCE POST /apis/velero.io/v1/namespaces/velero/schedules
+// @API CCE GET /apis/velero.io/v1/namespaces/velero/schedules/{name}
+// @API CCE PATCH /apis/velero.io/v1/namespaces/velero/schedules/{name}
+// @API CCE DELETE /apis/velero.io/v1/namespaces/velero/schedules/{name}
+func ResourceBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupPlanCreate,
+		ReadContext:   resourceBackupPlanRead,
+		UpdateContext: resourceBackupPlanUpdate,
+		DeleteContext: resourceBackupPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBackupPlanImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_location": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"provider": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "huawei",
+						},
+						"config": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"credential_secret_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"storage_location.0.credential_secret_key"},
+						},
+						"credential_secret_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"included_namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"label_selector": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include_cluster_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"backup_volumes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"retention_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      720,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_backup_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildBackupResourcePath(client *golangsdk.ServiceClient, clusterId, resourceType string) (string, error) {
+	baseUrl, err := buildClusterApiServerURL(client, clusterId)
+	if err != nil {
+		return "", err
+	}
+	return baseUrl + backupNamespacesHttpUrl + resourceType, nil
+}
+
+func doBackupResourceRequest(client *golangsdk.ServiceClient, method, clusterId, resourceType, name string,
+	body map[string]interface{}) (interface{}, error) {
+	path, err := buildBackupResourcePath(client, clusterId, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		path += "/" + name
+	}
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 202},
+	}
+	if body != nil {
+		opt.JSONBody = body
+	}
+	if method == "PATCH" {
+		opt.MoreHeaders = map[string]string{
+			"Content-Type": "application/merge-patch+json",
+		}
+	}
+	resp, err := client.Request(method, path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func buildBackupLabelSelector(labels map[string]interface{}) map[string]interface{} {
+	if len(labels) == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"matchLabels": labels,
+	}
+}
+
+func buildBackupStorageLocationSpec(d *schema.ResourceData) map[string]interface{} {
+	storageLocation := d.Get("storage_location.0").(map[string]interface{})
+	spec := map[string]interface{}{
+		"provider": storageLocation["provider"],
+		"objectStorage": map[string]interface{}{
+			"bucket": storageLocation["bucket"],
+			"prefix": utils.ValueIngoreEmpty(storageLocation["prefix"]),
+		},
+		"config": utils.ValueIngoreEmpty(storageLocation["config"]),
+	}
+	if secretName := storageLocation["credential_secret_name"].(string); secretName != "" {
+		spec["credential"] = map[string]interface{}{
+			"name": secretName,
+			"key":  storageLocation["credential_secret_key"],
+		}
+	}
+	return spec
+}
+
+func buildBackupScheduleSpec(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"schedule": d.Get("schedule"),
+		"paused":   d.Get("paused"),
+		"template": map[string]interface{}{
+			"storageLocation":          d.Get("name"),
+			"includedNamespaces":       utils.ExpandToStringListBySet(d.Get("included_namespaces").(*schema.Set)),
+			"excludedNamespaces":       utils.ExpandToStringListBySet(d.Get("excluded_namespaces").(*schema.Set)),
+			"labelSelector":            buildBackupLabelSelector(d.Get("label_selector").(map[string]interface{})),
+			"includeClusterResources":  d.Get("include_cluster_resources"),
+			"defaultVolumesToFsBackup": d.Get("backup_volumes"),
+			"ttl":                      fmt.Sprintf("%dh0m0s", d.Get("retention_hours").(int)),
+		},
+	}
+}
+
+func resourceBackupPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	var (
+		clusterId = d.Get("cluster_id").(string)
+		name      = d.Get("name").(string)
+		metadata  = map[string]interface{}{
+			"name":      name,
+			"namespace": "velero",
+		}
+	)
+	_, err = doBackupResourceRequest(client, "POST", clusterId, backupStorageLocationResource, "", map[string]interface{}{
+		"apiVersion": backupApiVersion,
+		"kind":       "BackupStorageLocation",
+		"metadata":   metadata,
+		"spec":       utils.RemoveNil(buildBackupStorageLocationSpec(d)),
+	})
+	if err != nil {
+		return diag.Errorf("error creating the storage location of CCE backup plan: %s", err)
+	}
+
+	_, err = doBackupResourceRequest(client, "POST", clusterId, backupScheduleResource, "", map[string]interface{}{
+		"apiVersion": backupApiVersion,
+		"kind":       "Schedule",
+		"metadata":   metadata,
+		"spec":       utils.RemoveNil(buildBackupScheduleSpec(d)),
+	})
+	if err != nil {
+		// Remove the storage location created above, otherwise the next apply will fail because it already exists.
+		_, delErr := doBackupResourceRequest(client, "DELETE", clusterId, backupStorageLocationResource, name, nil)
+		if delErr != nil {
+			err = multierror.Append(err, fmt.Errorf("error deleting the storage location: %s", delErr))
+		}
+		return diag.Errorf("error creating CCE backup plan: %s", err)
+	}
+	d.SetId(name)
+
+	return resourceBackupPlanRead(ctx, d, meta)
+}
+
+func flattenBackupStorageLocation(location interface{}) []map[string]interface{} {
+	if location == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"bucket":                 utils.PathSearch("spec.objectStorage.bucket", location, nil),
+			"prefix":                 utils.PathSearch("spec.objectStorage.prefix", location, nil),
+			"provider":               utils.PathSearch("spec.provider", location, nil),
+			"config":                 utils.PathSearch("spec.config", location, nil),
+			"credential_secret_name": utils.PathSearch("spec.credential.name", location, nil),
+			"credential_secret_key":  utils.PathSearch("spec.credential.key", location, nil),
+		},
+	}
+}
+
+func parseBackupRetentionHours(ttl string) int {
+	var hours int
+	if _, err := fmt.Sscanf(ttl, "%dh", &hours); err != nil {
+		return 0
+	}
+	return hours
+}
+
+func resourceBackupPlanRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	schedule, err := doBackupResourceRequest(client, "GET", clusterId, backupScheduleResource, d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE backup plan")
+	}
+	storageLocationName := utils.PathSearch("spec.template.storageLocation", schedule, "").(string)
+	location, err := doBackupResourceRequest(client, "GET", clusterId, backupStorageLocationResource,
+		storageLocationName, nil)
+	if err != nil {
+		return diag.Errorf("error retrieving the storage location (%s) of CCE backup plan: %s", storageLocationName,
+			err)
+	}
+
+	var labelSelector interface{}
+	if v := utils.PathSearch("spec.template.labelSelector.matchLabels", schedule, nil); v != nil {
+		labelSelector = v
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("metadata.name", schedule, nil)),
+		d.Set("schedule", utils.PathSearch("spec.schedule", schedule, nil)),
+		d.Set("paused", utils.PathSearch("spec.paused", schedule, false)),
+		d.Set("storage_location", flattenBackupStorageLocation(location)),
+		d.Set("included_namespaces", utils.PathSearch("spec.template.includedNamespaces", schedule, nil)),
+		d.Set("excluded_namespaces", utils.PathSearch("spec.template.excludedNamespaces", schedule, nil)),
+		d.Set("label_selector", labelSelector),
+		d.Set("include_cluster_resources", utils.PathSearch("spec.template.includeClusterResources", schedule, nil)),
+		d.Set("backup_volumes", utils.PathSearch("spec.template.defaultVolumesToFsBackup", schedule, false)),
+		d.Set("retention_hours", parseBackupRetentionHours(utils.PathSearch("spec.template.ttl", schedule,
+			"").(string))),
+		d.Set("phase", utils.PathSearch("status.phase", schedule, nil)),
+		d.Set("last_backup_at", utils.PathSearch("status.lastBackup", schedule, nil)),
+		d.Set("created_at", utils.PathSearch("metadata.creationTimestamp", schedule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE backup plan fields: %s", err)
+	}
+	return nil
+}
+
+func resourceBackupPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	if d.HasChange("storage_location") {
+		// The JSON merge patch removes the fields whose value is null.
+		spec := buildBackupStorageLocationSpec(d)
+		if _, ok := spec["credential"]; !ok {
+			spec["credential"] = nil
+		}
+		_, err = doBackupResourceRequest(client, "PATCH", clusterId, backupStorageLocationResource, d.Id(),
+			map[string]interface{}{"spec": spec})
+		if err != nil {
+			return diag.Errorf("error updating the storage location of CCE backup plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	_, err = doBackupResourceRequest(client, "PATCH", clusterId, backupScheduleResource, d.Id(),
+		map[string]interface{}{"spec": buildBackupScheduleSpec(d)})
+	if err != nil {
+		return diag.Errorf("error updating CCE backup plan (%s): %s", d.Id(), err)
+	}
+
+	return resourceBackupPlanRead(ctx, d, meta)
+}
+
+func resourceBackupPlanDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	_, err = doBackupResourceRequest(client, "DELETE", clusterId, backupScheduleResource, d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE backup plan")
+	}
+	// The backups created by the plan are kept in the OBS bucket until they expire.
+	_, err = doBackupResourceRequest(client, "DELETE", clusterId, backupStorageLocationResource, d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting the storage location of CCE backup plan")
+	}
+	return nil
+}
+
+func resourceBackupPlanImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format of import ID, want '<cluster_id>/<name>', but got '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
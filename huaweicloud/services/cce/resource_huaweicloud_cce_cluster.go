@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,6 +39,15 @@ import (
 // @API CCE PUT /api/v3/projects/{project_id}/clusters/{id}/mastereip
 // @API CCE POST /api/v3/projects/{project_id}/clusters/{id}/operation/{action}
 // @API CCE POST /api/v3/projects/{project_id}/clusters/{id}/tags/{action}
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{cluster_id}/upgradeinfo
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/precheck
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/precheck/tasks/{task_id}
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/snapshot
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/snapshot/tasks
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/tasks
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/upgrade/tasks/{task_id}
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{cluster_id}/operation/postcheck
 // @API AOM POST /svcstg/icmgr/v1/{project_id}/agents
 func ResourceCCEClusterV3() *schema.Resource {
 	return ResourceCluster()
@@ -77,6 +88,12 @@ func ResourceCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if d.HasChange("cluster_version") {
+				return d.SetNewComputed("upgrade_postcheck_status")
+			}
+			return nil
+		},
 
 		//request and response parameters
 		Schema: map[string]*schema.Schema{
@@ -100,9 +117,58 @@ func ResourceCluster() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ForceNew:         true,
 				DiffSuppressFunc: utils.SuppressVersionDiffs,
 			},
+			"upgrade_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"skip_pre_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"snapshot": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"skip_post_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"step": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"addons": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"template_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsJSON,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"upgrade_postcheck_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cluster_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -978,6 +1044,26 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("cluster_version") {
+		if err = resourceClusterUpgrade(ctx, d, cceClient); err != nil {
+			// Keep the old version in the state so that the upgrade can be resumed in the next apply.
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		postcheckStatus, err := postcheckClusterUpgrade(d, cceClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("upgrade_postcheck_status", postcheckStatus); err != nil {
+			return diag.Errorf("error saving the post-upgrade check status of CCE cluster (%s): %s", clusterId, err)
+		}
+		if postcheckStatus == "Failed" {
+			return diag.Errorf("the post-upgrade check of CCE cluster (%s) failed, please check the cluster status",
+				clusterId)
+		}
+	}
+
 	if d.HasChange("hibernate") {
 		if d.Get("hibernate").(bool) {
 			err = resourceClusterHibernate(ctx, d, cceClient)
@@ -1239,3 +1325,365 @@ func resourceClusterEipAction(cceClient, eipClient *golangsdk.ServiceClient,
 	}
 	return nil
 }
+
+// parseClusterVersion parses the major and minor numbers of the cluster version, e.g. v1.25.5-r0 is parsed as [1, 25].
+func parseClusterVersion(version string) []int {
+	parts := regexp.MustCompile(`[\.\-]+`).Split(strings.TrimPrefix(version, "v"), -1)
+	result := make([]int, 0, 2)
+	for i := 0; i < len(parts) && i < 2; i++ {
+		num, err := strconv.Atoi(parts[i])
+		if err != nil {
+			break
+		}
+		result = append(result, num)
+	}
+	return result
+}
+
+// compareClusterVersion returns a negative number if version a is lower than version b, zero if they have the same
+// major and minor numbers, and a positive number otherwise.
+func compareClusterVersion(a, b string) int {
+	aNums := parseClusterVersion(a)
+	bNums := parseClusterVersion(b)
+	for i := 0; i < len(aNums) && i < len(bNums); i++ {
+		if aNums[i] != bNums[i] {
+			return aNums[i] - bNums[i]
+		}
+	}
+	return len(aNums) - len(bNums)
+}
+
+func buildClusterOperationPath(client *golangsdk.ServiceClient, clusterId, operationPath string) string {
+	path := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/" + operationPath
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{cluster_id}", clusterId)
+	return path
+}
+
+func doClusterOperationRequest(client *golangsdk.ServiceClient, method, path string,
+	body map[string]interface{}) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	if body != nil {
+		opt.JSONBody = utils.RemoveNil(body)
+	}
+	resp, err := client.Request(method, path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// getClusterUpgradeNextVersion returns the version to which the cluster can be upgraded directly. If the target
+// version cannot be reached in one step, the highest intermediate version lower than the target version is returned.
+func getClusterUpgradeNextVersion(client *golangsdk.ServiceClient, clusterId, targetVersion string) (string, error) {
+	respBody, err := doClusterOperationRequest(client, "GET",
+		buildClusterOperationPath(client, clusterId, "upgradeinfo"), nil)
+	if err != nil {
+		return "", fmt.Errorf("error querying the upgrade information of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	var nextVersion string
+	candidates := utils.PathSearch("spec.versionInfo.targetVersions", respBody, make([]interface{}, 0)).([]interface{})
+	for _, v := range candidates {
+		candidate := v.(string)
+		if utils.SuppressVersionDiffs("", candidate, targetVersion, nil) {
+			return candidate, nil
+		}
+		if compareClusterVersion(candidate, targetVersion) < 0 &&
+			(nextVersion == "" || compareClusterVersion(candidate, nextVersion) > 0) {
+			nextVersion = candidate
+		}
+	}
+	if nextVersion == "" {
+		return "", fmt.Errorf("CCE cluster (%s) can not be upgraded to %s, the supported target versions are %v",
+			clusterId, targetVersion, candidates)
+	}
+	return nextVersion, nil
+}
+
+func clusterOperationTaskRefreshFunc(client *golangsdk.ServiceClient, path, operation string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := doClusterOperationRequest(client, "GET", path, nil)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		phase := utils.PathSearch("status.phase", respBody, "").(string)
+		switch phase {
+		case "Success":
+			return respBody, "COMPLETED", nil
+		case "Failed", "Error":
+			return respBody, "ERROR", fmt.Errorf("the %s task failed: %v", operation,
+				utils.PathSearch("status.message || status.reason", respBody, ""))
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForClusterOperationTask(ctx context.Context, client *golangsdk.ServiceClient, path, operation string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      clusterOperationTaskRefreshFunc(client, path, operation),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func precheckClusterUpgrade(ctx context.Context, client *golangsdk.ServiceClient, clusterId, targetVersion string,
+	timeout time.Duration) error {
+	body := map[string]interface{}{
+		"apiVersion": "v3",
+		"kind":       "PreCheckTask",
+		"spec": map[string]interface{}{
+			"clusterUpgradeAction": map[string]interface{}{
+				"targetVersion": targetVersion,
+			},
+		},
+	}
+	respBody, err := doClusterOperationRequest(client, "POST",
+		buildClusterOperationPath(client, clusterId, "operation/precheck"), body)
+	if err != nil {
+		return fmt.Errorf("error running the pre-upgrade check of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	taskId := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if taskId == "" {
+		return fmt.Errorf("unable to find the pre-upgrade check task ID of CCE cluster (%s)", clusterId)
+	}
+	taskPath := buildClusterOperationPath(client, clusterId, "operation/precheck/tasks/"+taskId)
+	if err = waitForClusterOperationTask(ctx, client, taskPath, "pre-upgrade check", timeout); err != nil {
+		return fmt.Errorf("error waiting for the pre-upgrade check of CCE cluster (%s) to complete: %s",
+			clusterId, err)
+	}
+	return nil
+}
+
+func snapshotClusterBeforeUpgrade(ctx context.Context, client *golangsdk.ServiceClient, clusterId string,
+	timeout time.Duration) error {
+	respBody, err := doClusterOperationRequest(client, "POST",
+		buildClusterOperationPath(client, clusterId, "operation/snapshot"), map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("error creating the snapshot of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	taskId := utils.PathSearch("uid", respBody, "").(string)
+	listPath := buildClusterOperationPath(client, clusterId, "operation/snapshot/tasks")
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			listResp, err := doClusterOperationRequest(client, "GET", listPath, nil)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			task := utils.PathSearch(fmt.Sprintf("items[?metadata.uid=='%s']|[0]", taskId), listResp, nil)
+			if task == nil {
+				return listResp, "PENDING", nil
+			}
+			switch utils.PathSearch("status.phase", task, "").(string) {
+			case "Success":
+				return task, "COMPLETED", nil
+			case "Failed":
+				return task, "ERROR", fmt.Errorf("the snapshot task failed")
+			}
+			return task, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the snapshot of CCE cluster (%s) to complete: %s", clusterId, err)
+	}
+	return nil
+}
+
+func buildClusterUpgradeAddonsBodyParams(addons []interface{}) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0, len(addons))
+	for _, v := range addons {
+		addon := v.(map[string]interface{})
+		params := map[string]interface{}{
+			"addonTemplateName": addon["template_name"],
+			"operation":         "patch",
+			"version":           addon["version"],
+		}
+		if values := addon["values"].(string); values != "" {
+			var valuesMap map[string]interface{}
+			if err := json.Unmarshal([]byte(values), &valuesMap); err != nil {
+				return nil, fmt.Errorf("error parsing the values of addon (%s): %s", addon["template_name"], err)
+			}
+			params["values"] = valuesMap
+		}
+		result = append(result, params)
+	}
+	return result, nil
+}
+
+func buildClusterUpgradeBodyParams(d *schema.ResourceData, targetVersion string,
+	withAddons bool) (map[string]interface{}, error) {
+	action := map[string]interface{}{
+		"targetVersion": targetVersion,
+		"strategy": map[string]interface{}{
+			"type": "inPlaceRollingUpdate",
+			"inPlaceRollingUpdate": utils.RemoveNil(map[string]interface{}{
+				"userDefinedStep": utils.ValueIngoreEmpty(d.Get("upgrade_options.0.step")),
+			}),
+		},
+	}
+	// The addons are only upgraded along with the final version, the intermediate upgrades use the default addon
+	// versions selected by the CCE service.
+	if withAddons {
+		addons, err := buildClusterUpgradeAddonsBodyParams(d.Get("upgrade_options.0.addons").([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		if len(addons) > 0 {
+			action["addons"] = addons
+		}
+	}
+
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "UpgradeTask",
+		},
+		"spec": map[string]interface{}{
+			"clusterUpgradeAction": action,
+		},
+	}, nil
+}
+
+// getRunningClusterUpgradeTask returns the ID of the upgrade task which is still running, e.g. the task started by a
+// previous apply which has timed out.
+func getRunningClusterUpgradeTask(client *golangsdk.ServiceClient, clusterId string) (string, error) {
+	respBody, err := doClusterOperationRequest(client, "GET",
+		buildClusterOperationPath(client, clusterId, "operation/upgrade/tasks"), nil)
+	if err != nil {
+		return "", fmt.Errorf("error querying the upgrade tasks of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	taskId := utils.PathSearch("items[?status.phase!='Success' && status.phase!='Failed']|[0].metadata.uid",
+		respBody, "").(string)
+	return taskId, nil
+}
+
+// postcheckClusterUpgrade runs the post-upgrade check and returns its status, which is Skipped if the check is
+// disabled by the upgrade options.
+func postcheckClusterUpgrade(d *schema.ResourceData, client *golangsdk.ServiceClient) (string, error) {
+	if d.Get("upgrade_options.0.skip_post_check").(bool) {
+		return "Skipped", nil
+	}
+
+	clusterId := d.Id()
+	originVersion, targetVersion := d.GetChange("cluster_version")
+	body := map[string]interface{}{
+		"apiVersion": "v3",
+		"kind":       "PostCheckTask",
+		"spec": map[string]interface{}{
+			"clusterID":      clusterId,
+			"clusterVersion": originVersion,
+			"targetVersion":  targetVersion,
+		},
+	}
+	respBody, err := doClusterOperationRequest(client, "POST",
+		buildClusterOperationPath(client, clusterId, "operation/postcheck"), body)
+	if err != nil {
+		return "", fmt.Errorf("error running the post-upgrade check of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	phase := utils.PathSearch("status.phase", respBody, "").(string)
+	log.Printf("[DEBUG] The post-upgrade check result of CCE cluster (%s) is: %v", clusterId,
+		utils.PathSearch("status", respBody, nil))
+	return phase, nil
+}
+
+func upgradeClusterToVersion(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	clusterId, version string, withAddons bool) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if !d.Get("upgrade_options.0.skip_pre_check").(bool) {
+		if err := precheckClusterUpgrade(ctx, client, clusterId, version, timeout); err != nil {
+			return err
+		}
+	}
+	if d.Get("upgrade_options.0.snapshot").(bool) {
+		if err := snapshotClusterBeforeUpgrade(ctx, client, clusterId, timeout); err != nil {
+			return err
+		}
+	}
+
+	body, err := buildClusterUpgradeBodyParams(d, version, withAddons)
+	if err != nil {
+		return err
+	}
+	respBody, err := doClusterOperationRequest(client, "POST",
+		buildClusterOperationPath(client, clusterId, "operation/upgrade"), body)
+	if err != nil {
+		return fmt.Errorf("error upgrading CCE cluster (%s) to %s: %s", clusterId, version, err)
+	}
+
+	taskId := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if taskId == "" {
+		return fmt.Errorf("unable to find the upgrade task ID of CCE cluster (%s)", clusterId)
+	}
+	taskPath := buildClusterOperationPath(client, clusterId, "operation/upgrade/tasks/"+taskId)
+	if err = waitForClusterOperationTask(ctx, client, taskPath, "upgrade", timeout); err != nil {
+		return fmt.Errorf("error waiting for CCE cluster (%s) to be upgraded to %s: %s", clusterId, version, err)
+	}
+	return nil
+}
+
+// resourceClusterUpgrade upgrades the cluster in place, through the intermediate versions if needed.
+func resourceClusterUpgrade(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	clusterId := d.Id()
+	oldVersion, newVersion := d.GetChange("cluster_version")
+	targetVersion := newVersion.(string)
+	if compareClusterVersion(targetVersion, oldVersion.(string)) < 0 {
+		return fmt.Errorf("the cluster version can not be downgraded from %s to %s", oldVersion, targetVersion)
+	}
+
+	// Resume the upgrade task which is still running before starting a new one.
+	runningTaskId, err := getRunningClusterUpgradeTask(client, clusterId)
+	if err != nil {
+		return err
+	}
+	if runningTaskId != "" {
+		log.Printf("[DEBUG] Waiting for the running upgrade task (%s) of CCE cluster (%s) to complete",
+			runningTaskId, clusterId)
+		taskPath := buildClusterOperationPath(client, clusterId, "operation/upgrade/tasks/"+runningTaskId)
+		err = waitForClusterOperationTask(ctx, client, taskPath, "upgrade", d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error waiting for the upgrade task (%s) of CCE cluster (%s) to complete: %s",
+				runningTaskId, clusterId, err)
+		}
+	}
+
+	for {
+		cluster, err := clusters.Get(client, clusterId).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving CCE cluster (%s): %s", clusterId, err)
+		}
+		currentVersion := cluster.Spec.Version
+		if utils.SuppressVersionDiffs("", currentVersion, targetVersion, nil) {
+			break
+		}
+
+		nextVersion, err := getClusterUpgradeNextVersion(client, clusterId, targetVersion)
+		if err != nil {
+			return err
+		}
+		isFinal := utils.SuppressVersionDiffs("", nextVersion, targetVersion, nil)
+		log.Printf("[DEBUG] Upgrading CCE cluster (%s) from %s to %s", clusterId, currentVersion, nextVersion)
+		if err = upgradeClusterToVersion(ctx, d, client, clusterId, nextVersion, isFinal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package cce
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{cluster_id}/clustercert/revoke
+func ResourceClusterCertificateRevoke() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterCertificateRevokeCreate,
+		ReadContext:   resourceClusterCertificateRevokeRead,
+		DeleteContext: resourceClusterCertificateRevokeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "agency_id"},
+			},
+			"agency_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "agency_id"},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildClusterCertificateRevokeBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"userId":   utils.ValueIngoreEmpty(d.Get("user_id")),
+		"agencyId": utils.ValueIngoreEmpty(d.Get("agency_id")),
+	}
+}
+
+func resourceClusterCertificateRevokeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	_, err = doClusterOperationRequest(client, "POST", buildClusterOperationPath(client, clusterId, "clustercert/revoke"),
+		buildClusterCertificateRevokeBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error revoking the certificates of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceClusterCertificateRevokeRead(ctx, d, meta)
+}
+
+func resourceClusterCertificateRevokeRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceClusterCertificateRevokeDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting CCE cluster certificate revoke is not supported. The revocation is only removed from the " +
+		"state, and the revoked certificates will not be restored."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
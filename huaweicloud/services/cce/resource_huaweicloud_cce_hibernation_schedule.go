@@ -0,0 +1,509 @@
+package cce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const hibernationScheduleTriggerType = "TIMER"
+
+// hibernationScheduleHandler is the code of the function which is invoked every minute by the timer trigger. It
+// evaluates the cron expressions in the configured time zone, and hibernates or wakes up the cluster and its node
+// pools in order. A run is skipped if the cluster or the node pools are still changing by the previous run.
+const hibernationScheduleHandler = `# -*- coding:utf-8 -*-
+import json
+import time
+import urllib.request
+from datetime import datetime
+from zoneinfo import ZoneInfo
+
+
+def match_field(expr, value, low, high):
+    for part in expr.split(","):
+        step = 1
+        if "/" in part:
+            part, step = part.split("/")
+            step = int(step)
+        if part == "*":
+            start, end = low, high
+        elif "-" in part:
+            start, end = [int(v) for v in part.split("-")]
+        else:
+            start = int(part)
+            end = high if step > 1 else start
+        if start <= value <= end and (value - start) % step == 0:
+            return True
+    return False
+
+
+def cron_matches(expr, now):
+    minute, hour, day, month, week = expr.split()
+    weekday = now.isoweekday() % 7
+    day_matched = match_field(day, now.day, 1, 31)
+    week_matched = match_field(week, weekday, 0, 7) or (weekday == 0 and match_field(week, 7, 0, 7))
+    # The same as the standard cron, the day of month and the day of week are ORed if both are restricted.
+    if day != "*" and week != "*":
+        date_matched = day_matched or week_matched
+    else:
+        date_matched = day_matched and week_matched
+    return (match_field(minute, now.minute, 0, 59) and match_field(hour, now.hour, 0, 23) and
+            match_field(month, now.month, 1, 12) and date_matched)
+
+
+class Client:
+    def __init__(self, context):
+        self.token = context.getToken()
+        self.base = "%sapi/v3/projects/%s/clusters/%s" % (
+            context.getUserData("CCE_ENDPOINT"), context.getUserData("PROJECT_ID"),
+            context.getUserData("CLUSTER_ID"))
+        self.logger = context.getLogger()
+
+    def request(self, method, path, body=None):
+        data = json.dumps(body).encode("utf-8") if body is not None else None
+        req = urllib.request.Request(self.base + path, data=data, method=method)
+        req.add_header("Content-Type", "application/json")
+        req.add_header("X-Auth-Token", self.token)
+        with urllib.request.urlopen(req) as resp:
+            content = resp.read()
+            return json.loads(content) if content else {}
+
+    def wait(self, path, expression, timeout=1800):
+        deadline = time.time() + timeout
+        while time.time() < deadline:
+            if expression(self.request("GET", path)):
+                return
+            time.sleep(15)
+        raise Exception("timeout waiting for %s" % path)
+
+    def cluster_phase(self):
+        return self.request("GET", "").get("status", {}).get("phase")
+
+    def is_busy(self, node_pools):
+        # The previous run may be still in progress, e.g. the cron expression matches several minutes in a row.
+        if self.cluster_phase() not in ("Available", "Hibernation"):
+            return True
+        for pool in node_pools:
+            status = self.request("GET", "/nodepools/%s" % pool["node_pool_id"]).get("status", {})
+            if status.get("phase", "") not in ("", "Active"):
+                return True
+        return False
+
+    def scale_node_pool(self, node_pool_id, count):
+        path = "/nodepools/%s" % node_pool_id
+        pool = self.request("GET", path)
+        pool["spec"]["initialNodeCount"] = count
+        self.logger.info("scaling node pool %s to %d nodes" % (node_pool_id, count))
+        self.request("PUT", path, {"metadata": pool["metadata"], "spec": pool["spec"]})
+        self.wait(path, lambda p: p.get("status", {}).get("currentNode", 0) == count and
+                  p.get("status", {}).get("phase", "") in ("", "Active"))
+
+    def hibernate(self, node_pools):
+        if self.cluster_phase() != "Available":
+            return
+        for pool in reversed(node_pools):
+            self.scale_node_pool(pool["node_pool_id"], 0)
+        self.logger.info("hibernating the cluster")
+        self.request("POST", "/operation/hibernate")
+
+    def wakeup(self, node_pools):
+        if self.cluster_phase() == "Hibernation":
+            self.logger.info("waking up the cluster")
+            self.request("POST", "/operation/awake")
+        self.wait("", lambda c: c.get("status", {}).get("phase") == "Available")
+        for pool in node_pools:
+            self.scale_node_pool(pool["node_pool_id"], pool["node_count"])
+
+
+def handler(event, context):
+    now = datetime.now(ZoneInfo(context.getUserData("TIME_ZONE")))
+    node_pools = json.loads(context.getUserData("NODE_POOLS") or "[]")
+    client = Client(context)
+    hibernate = cron_matches(context.getUserData("HIBERNATE_CRON"), now)
+    wakeup = not hibernate and cron_matches(context.getUserData("WAKEUP_CRON"), now)
+    if (hibernate or wakeup) and client.is_busy(node_pools):
+        client.logger.info("skip this run because the previous run is still in progress")
+        return {"statusCode": 200}
+    if hibernate:
+        client.hibernate(node_pools)
+    elif wakeup:
+        client.wakeup(node_pools)
+    return {"statusCode": 200}
+`
+
+var cronExpressionRegex = regexp.MustCompile(`^[\d*,/\-]+(\s+[\d*,/\-]+){4}$`)
+
+// @API FunctionGraph POST /v2/{project_id}/fgs/functions
+// @API FunctionGraph GET /v2/{project_id}/fgs/functions/{function_urn}/config
+// @API FunctionGraph PUT /v2/{project_id}/fgs/functions/{function_urn}/config
+// @API FunctionGraph PUT /v2/{project_id}/fgs/functions/{function_urn}/config-max-instance
+// @API FunctionGraph DELETE /v2/{project_id}/fgs/functions/{function_urn}
+// @API FunctionGraph POST /v2/{project_id}/fgs/triggers/{function_urn}
+// @API FunctionGraph GET /v2/{project_id}/fgs/triggers/{function_urn}
+// @API FunctionGraph PUT /v2/{project_id}/fgs/triggers/{function_urn}/{trigger_type_code}/{trigger_id}
+func ResourceHibernationSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHibernationScheduleCreate,
+		ReadContext:   resourceHibernationScheduleRead,
+		UpdateContext: resourceHibernationScheduleUpdate,
+		DeleteContext: resourceHibernationScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agency_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hibernate_schedule": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(cronExpressionRegex,
+					"the value must be a cron expression with 5 fields"),
+			},
+			"wakeup_schedule": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(cronExpressionRegex,
+					"the value must be a cron expression with 5 fields"),
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimeZone,
+			},
+			"node_pools": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_pool_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"node_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"function_urn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trigger_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateTimeZone(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a valid IANA time zone, e.g. Asia/Shanghai: %s", k, err))
+	}
+	return
+}
+
+func buildHibernationScheduleNodePools(nodePools []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(nodePools))
+	for _, v := range nodePools {
+		nodePool := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"node_pool_id": nodePool["node_pool_id"],
+			"node_count":   nodePool["node_count"],
+		})
+	}
+	return result
+}
+
+func buildHibernationScheduleUserData(d *schema.ResourceData, cceClient *golangsdk.ServiceClient) (string, error) {
+	nodePools, err := json.Marshal(buildHibernationScheduleNodePools(d.Get("node_pools").([]interface{})))
+	if err != nil {
+		return "", err
+	}
+	userData, err := json.Marshal(map[string]interface{}{
+		"CCE_ENDPOINT":   cceClient.Endpoint,
+		"PROJECT_ID":     cceClient.ProjectID,
+		"CLUSTER_ID":     d.Get("cluster_id"),
+		"HIBERNATE_CRON": d.Get("hibernate_schedule"),
+		"WAKEUP_CRON":    d.Get("wakeup_schedule"),
+		"TIME_ZONE":      d.Get("time_zone"),
+		"NODE_POOLS":     string(nodePools),
+	})
+	return string(userData), err
+}
+
+func buildHibernationScheduleFunctionBodyParams(d *schema.ResourceData, userData string) map[string]interface{} {
+	return map[string]interface{}{
+		"func_name":   d.Get("name"),
+		"package":     "default",
+		"runtime":     "Python3.9",
+		"handler":     "index.handler",
+		"memory_size": 128,
+		// The wake-up waits for the cluster and all node pools to become ready.
+		"timeout":   3600,
+		"code_type": "inline",
+		"func_code": map[string]interface{}{
+			"file": utils.TryBase64EncodeString(hibernationScheduleHandler),
+		},
+		"xrole":       d.Get("agency_name"),
+		"user_data":   userData,
+		"description": fmt.Sprintf("Hibernation schedule of CCE cluster %s", d.Get("cluster_id")),
+	}
+}
+
+func buildHibernationScheduleTriggerStatus(d *schema.ResourceData) string {
+	if d.Get("enabled").(bool) {
+		return "ACTIVE"
+	}
+	return "DISABLED"
+}
+
+func doFunctionGraphRequest(client *golangsdk.ServiceClient, method, httpUrl, functionUrn string,
+	body map[string]interface{}) (interface{}, error) {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{function_urn}", functionUrn)
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	if body != nil {
+		opt.JSONBody = body
+	}
+	resp, err := client.Request(method, path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	if method == "DELETE" {
+		return nil, nil
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func resourceHibernationScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cceClient, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+	fgsClient, err := cfg.FgsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	userData, err := buildHibernationScheduleUserData(d, cceClient)
+	if err != nil {
+		return diag.Errorf("error building the user data of the hibernation schedule: %s", err)
+	}
+	respBody, err := doFunctionGraphRequest(fgsClient, "POST", "v2/{project_id}/fgs/functions", "",
+		buildHibernationScheduleFunctionBodyParams(d, userData))
+	if err != nil {
+		return diag.Errorf("error creating the function of CCE hibernation schedule: %s", err)
+	}
+
+	functionUrn := utils.PathSearch("func_urn", respBody, "").(string)
+	if functionUrn == "" {
+		return diag.Errorf("unable to find the function URN from the API response")
+	}
+	// The ID is the function URN without the version.
+	d.SetId(functionUrn[:strings.LastIndex(functionUrn, ":")])
+
+	// Only one instance is allowed, so the runs triggered during a long run are not executed concurrently.
+	_, err = doFunctionGraphRequest(fgsClient, "PUT", "v2/{project_id}/fgs/functions/{function_urn}/config-max-instance",
+		functionUrn, map[string]interface{}{"max_instance_num": 1})
+	if err != nil {
+		return diag.Errorf("error limiting the instances of CCE hibernation schedule (%s): %s", d.Id(), err)
+	}
+
+	triggerOpts := map[string]interface{}{
+		"trigger_type_code": hibernationScheduleTriggerType,
+		"trigger_status":    buildHibernationScheduleTriggerStatus(d),
+		"event_data": map[string]interface{}{
+			"name":          d.Get("name"),
+			"schedule_type": "Rate",
+			"schedule":      "1m",
+		},
+	}
+	respBody, err = doFunctionGraphRequest(fgsClient, "POST", "v2/{project_id}/fgs/triggers/{function_urn}",
+		functionUrn, triggerOpts)
+	if err != nil {
+		return diag.Errorf("error creating the timer trigger of CCE hibernation schedule: %s", err)
+	}
+	if err = d.Set("trigger_id", utils.PathSearch("trigger_id", respBody, "")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHibernationScheduleRead(ctx, d, meta)
+}
+
+func flattenHibernationScheduleNodePools(nodePoolsRaw string) ([]interface{}, error) {
+	var nodePools []interface{}
+	if nodePoolsRaw == "" {
+		return nodePools, nil
+	}
+	err := json.Unmarshal([]byte(nodePoolsRaw), &nodePools)
+	return nodePools, err
+}
+
+func getHibernationScheduleTrigger(client *golangsdk.ServiceClient, functionUrn string) (interface{}, error) {
+	respBody, err := doFunctionGraphRequest(client, "GET", "v2/{project_id}/fgs/triggers/{function_urn}",
+		functionUrn+":latest", nil)
+	if err != nil {
+		return nil, err
+	}
+	expression := fmt.Sprintf("[?trigger_type_code=='%s']|[0]", hibernationScheduleTriggerType)
+	return utils.PathSearch(expression, respBody, nil), nil
+}
+
+func resourceHibernationScheduleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	fgsClient, err := cfg.FgsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	respBody, err := doFunctionGraphRequest(fgsClient, "GET", "v2/{project_id}/fgs/functions/{function_urn}/config",
+		d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE hibernation schedule")
+	}
+
+	userData := make(map[string]interface{})
+	if err = json.Unmarshal([]byte(utils.PathSearch("user_data", respBody, "{}").(string)), &userData); err != nil {
+		return diag.Errorf("error parsing the user data of the hibernation schedule: %s", err)
+	}
+	nodePools, err := flattenHibernationScheduleNodePools(utils.PathSearch("NODE_POOLS", userData, "").(string))
+	if err != nil {
+		return diag.Errorf("error parsing the node pools of the hibernation schedule: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("func_name", respBody, nil)),
+		d.Set("agency_name", utils.PathSearch("xrole", respBody, nil)),
+		d.Set("function_urn", utils.PathSearch("func_urn", respBody, nil)),
+		d.Set("cluster_id", utils.PathSearch("CLUSTER_ID", userData, nil)),
+		d.Set("hibernate_schedule", utils.PathSearch("HIBERNATE_CRON", userData, nil)),
+		d.Set("wakeup_schedule", utils.PathSearch("WAKEUP_CRON", userData, nil)),
+		d.Set("time_zone", utils.PathSearch("TIME_ZONE", userData, nil)),
+		d.Set("node_pools", nodePools),
+	)
+
+	trigger, err := getHibernationScheduleTrigger(fgsClient, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the timer trigger of CCE hibernation schedule: %s", err)
+	}
+	if trigger != nil {
+		mErr = multierror.Append(mErr,
+			d.Set("trigger_id", utils.PathSearch("trigger_id", trigger, nil)),
+			d.Set("enabled", utils.PathSearch("trigger_status", trigger, "") == "ACTIVE"),
+		)
+	}
+
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE hibernation schedule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceHibernationScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cceClient, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+	fgsClient, err := cfg.FgsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	if d.HasChanges("agency_name", "hibernate_schedule", "wakeup_schedule", "time_zone", "node_pools") {
+		userData, err := buildHibernationScheduleUserData(d, cceClient)
+		if err != nil {
+			return diag.Errorf("error building the user data of the hibernation schedule: %s", err)
+		}
+		body := buildHibernationScheduleFunctionBodyParams(d, userData)
+		delete(body, "func_name")
+		delete(body, "code_type")
+		delete(body, "func_code")
+		_, err = doFunctionGraphRequest(fgsClient, "PUT", "v2/{project_id}/fgs/functions/{function_urn}/config",
+			d.Id(), body)
+		if err != nil {
+			return diag.Errorf("error updating CCE hibernation schedule (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		triggerPath := fmt.Sprintf("v2/{project_id}/fgs/triggers/{function_urn}/%s/%s",
+			hibernationScheduleTriggerType, d.Get("trigger_id"))
+		body := map[string]interface{}{
+			"trigger_status": buildHibernationScheduleTriggerStatus(d),
+		}
+		_, err = doFunctionGraphRequest(fgsClient, "PUT", triggerPath, d.Id()+":latest", body)
+		if err != nil {
+			return diag.Errorf("error updating the timer trigger of CCE hibernation schedule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceHibernationScheduleRead(ctx, d, meta)
+}
+
+func resourceHibernationScheduleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	fgsClient, err := cfg.FgsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating FunctionGraph v2 client: %s", err)
+	}
+
+	// The triggers of the function are deleted together with the function.
+	_, err = doFunctionGraphRequest(fgsClient, "DELETE", "v2/{project_id}/fgs/functions/{function_urn}", d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE hibernation schedule")
+	}
+	return nil
+}
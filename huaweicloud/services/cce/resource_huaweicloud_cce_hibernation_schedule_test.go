@@ -0,0 +1,63 @@
+package cce
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestHibernationScheduleCronMatches runs the cron matcher of the function code with the local Python interpreter.
+func TestHibernationScheduleCronMatches(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not found, skip the test of the function code")
+	}
+
+	cases := []struct {
+		expr   string
+		now    string
+		expect bool
+	}{
+		{"0 22 * * *", "2026-10-16 22:00", true},
+		{"0 22 * * *", "2026-10-16 22:01", false},
+		{"*/15 8-18 * * 1-5", "2026-10-16 08:45", true},
+		{"*/15 8-18 * * 1-5", "2026-10-17 08:45", false},
+		{"0 0 1 * *", "2026-11-01 00:00", true},
+		{"0 0 * * 0", "2026-10-18 00:00", true},
+		{"0 0 * * 7", "2026-10-18 00:00", true},
+		// The day of month and the day of week are ORed if both are restricted.
+		{"0 0 1 * 5", "2026-10-16 00:00", true},
+		{"0 0 1 * 5", "2026-11-01 00:00", true},
+		{"0 0 1 * 5", "2026-10-15 00:00", false},
+		// The day of week is ignored if the day of month is not restricted.
+		{"0 0 * 10 5", "2026-10-15 00:00", false},
+	}
+
+	script := hibernationScheduleHandler + `
+import sys
+for line in sys.stdin.read().splitlines():
+    expr, now = line.split("|")
+    print(cron_matches(expr, datetime.strptime(now, "%Y-%m-%d %H:%M")))
+`
+	input := make([]string, len(cases))
+	for i, c := range cases {
+		input[i] = c.expr + "|" + c.now
+	}
+
+	cmd := exec.Command(python, "-c", script)
+	cmd.Stdin = strings.NewReader(strings.Join(input, "\n"))
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("error running the function code: %s\n%s", err, output)
+	}
+
+	results := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(results) != len(cases) {
+		t.Fatalf("want %d results, but got: %s", len(cases), output)
+	}
+	for i, c := range cases {
+		if got := results[i] == "True"; got != c.expect {
+			t.Errorf("cron_matches(%q, %s): want %v, but got %v", c.expr, c.now, c.expect, got)
+		}
+	}
+}
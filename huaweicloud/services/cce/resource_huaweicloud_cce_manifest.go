@@ -0,0 +1,643 @@
+package cce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// manifestObject is the identity of the Kubernetes object described by the manifest.
+type manifestObject struct {
+	ApiVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+	Body       map[string]interface{}
+}
+
+// @API CCE GET /api/v1
+// @API CCE GET /apis/{group}/{version}
+// @API CCE PATCH /api/v1/namespaces/{namespace}/{resource}/{name}
+// @API CCE GET /api/v1/namespaces/{namespace}/{resource}/{name}
+// @API CCE DELETE /api/v1/namespaces/{namespace}/{resource}/{name}
+// @API CCE PATCH /apis/{group}/{version}/namespaces/{namespace}/{resource}/{name}
+// @API CCE GET /apis/{group}/{version}/namespaces/{namespace}/{resource}/{name}
+// @API CCE DELETE /apis/{group}/{version}/namespaces/{namespace}/{resource}/{name}
+func ResourceManifest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceManifestCreate,
+		ReadContext:   resourceManifestRead,
+		UpdateContext: resourceManifestUpdate,
+		DeleteContext: resourceManifestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceManifestImportState,
+		},
+
+		CustomizeDiff: resourceManifestCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"manifest": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateManifest,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return isManifestEquivalent(old, new)
+				},
+			},
+			"field_manager": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "terraform",
+			},
+			"force_conflicts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"wait_for": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "True",
+						},
+					},
+				},
+			},
+			"deletion_propagation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Background",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Foreground", "Background", "Orphan",
+				}, false),
+			},
+			"api_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func parseManifest(manifest string) (*manifestObject, error) {
+	var body map[string]interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &body); err != nil {
+		return nil, fmt.Errorf("error parsing the manifest: %s", err)
+	}
+	// Normalize the YAML values to the JSON values, e.g. the integers are converted to float64.
+	normalized, err := normalizeManifestValue(body)
+	if err != nil {
+		return nil, err
+	}
+	body, _ = normalized.(map[string]interface{})
+
+	result := manifestObject{
+		ApiVersion: utils.PathSearch("apiVersion", body, "").(string),
+		Kind:       utils.PathSearch("kind", body, "").(string),
+		Name:       utils.PathSearch("metadata.name", body, "").(string),
+		Namespace:  utils.PathSearch("metadata.namespace", body, "").(string),
+		Body:       body,
+	}
+	if result.ApiVersion == "" || result.Kind == "" || result.Name == "" {
+		return nil, fmt.Errorf("the apiVersion, kind and metadata.name are required in the manifest")
+	}
+	return &result, nil
+}
+
+func normalizeManifestValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error converting the manifest to JSON: %s", err)
+	}
+	var result interface{}
+	err = json.Unmarshal(b, &result)
+	return result, err
+}
+
+func validateManifest(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseManifest(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %s", k, err))
+	}
+	return
+}
+
+func isManifestEquivalent(old, new string) bool {
+	oldObj, err := parseManifest(old)
+	if err != nil {
+		return false
+	}
+	newObj, err := parseManifest(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldObj.Body, newObj.Body)
+}
+
+// resourceManifestCustomizeDiff recreates the object if its identity is changed.
+func resourceManifestCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("manifest") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("manifest")
+	oldObj, err := parseManifest(oldRaw.(string))
+	if err != nil {
+		return nil
+	}
+	newObj, err := parseManifest(newRaw.(string))
+	if err != nil {
+		return err
+	}
+	if oldObj.ApiVersion != newObj.ApiVersion || oldObj.Kind != newObj.Kind || oldObj.Name != newObj.Name ||
+		oldObj.Namespace != newObj.Namespace {
+		return d.ForceNew("manifest")
+	}
+	return nil
+}
+
+func buildClusterApiServerURL(client *golangsdk.ServiceClient, clusterId string) (string, error) {
+	u, err := url.Parse(client.Endpoint)
+	if err != nil {
+		return "", err
+	}
+	u.Host = clusterId + "." + u.Host
+	return u.String(), nil
+}
+
+func buildManifestApiPrefix(apiVersion string) string {
+	if !strings.Contains(apiVersion, "/") {
+		return "api/" + apiVersion
+	}
+	return "apis/" + apiVersion
+}
+
+// getManifestObjectPath discovers the resource name of the object kind, and returns the object path.
+func getManifestObjectPath(client *golangsdk.ServiceClient, clusterId string, obj *manifestObject) (string, error) {
+	baseUrl, err := buildClusterApiServerURL(client, clusterId)
+	if err != nil {
+		return "", err
+	}
+	apiPrefix := buildManifestApiPrefix(obj.ApiVersion)
+
+	discoveryOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	discoveryResp, err := client.Request("GET", baseUrl+apiPrefix, &discoveryOpt)
+	if err != nil {
+		return "", fmt.Errorf("error discovering the API resources of %s: %s", obj.ApiVersion, err)
+	}
+	discoveryRespBody, err := utils.FlattenResponse(discoveryResp)
+	if err != nil {
+		return "", err
+	}
+
+	expression := fmt.Sprintf("resources[?kind=='%s' && !contains(name, '/')]|[0]", obj.Kind)
+	apiResource := utils.PathSearch(expression, discoveryRespBody, nil)
+	if apiResource == nil {
+		return "", fmt.Errorf("unable to find the resource of kind %s in %s", obj.Kind, obj.ApiVersion)
+	}
+
+	path := baseUrl + apiPrefix + "/"
+	if utils.PathSearch("namespaced", apiResource, false).(bool) {
+		namespace := obj.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		path += fmt.Sprintf("namespaces/%s/", namespace)
+	}
+	path += fmt.Sprintf("%s/%s", utils.PathSearch("name", apiResource, ""), obj.Name)
+	return path, nil
+}
+
+func applyManifest(client *golangsdk.ServiceClient, d *schema.ResourceData, path string,
+	obj *manifestObject) (interface{}, error) {
+	body, err := json.Marshal(obj.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	applyPath := fmt.Sprintf("%s?fieldManager=%s&force=%v", path, url.QueryEscape(d.Get("field_manager").(string)),
+		d.Get("force_conflicts").(bool))
+	applyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		// The JSON content is a valid YAML content, so it can be used for the server-side apply.
+		RawBody: bytes.NewReader(body),
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/apply-patch+yaml",
+		},
+		OkCodes: []int{200, 201},
+	}
+	applyResp, err := client.Request("PATCH", applyPath, &applyOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error applying the manifest (%s/%s): %s", obj.Kind, obj.Name, err)
+	}
+	return utils.FlattenResponse(applyResp)
+}
+
+func getManifestLiveObject(client *golangsdk.ServiceClient, path string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", path, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func manifestConditionsRefreshFunc(client *golangsdk.ServiceClient, path string,
+	conditions []interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getManifestLiveObject(client, path)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		for _, v := range conditions {
+			condition := v.(map[string]interface{})
+			expression := fmt.Sprintf("status.conditions[?type=='%s']|[0].status", condition["type"])
+			if utils.PathSearch(expression, respBody, "").(string) != condition["status"].(string) {
+				return respBody, "PENDING", nil
+			}
+		}
+		return respBody, "COMPLETED", nil
+	}
+}
+
+func waitForManifestConditions(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	path string, timeout time.Duration) error {
+	conditions := d.Get("wait_for").([]interface{})
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      manifestConditionsRefreshFunc(client, path, conditions),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceManifestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	obj, err := parseManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path, err := getManifestObjectPath(client, clusterId, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := applyManifest(client, d, path, obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	uid := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if uid == "" {
+		return diag.Errorf("unable to find the UID of the object (%s/%s)", obj.Kind, obj.Name)
+	}
+	d.SetId(uid)
+
+	if err = waitForManifestConditions(ctx, d, client, path, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the object (%s/%s) to become ready: %s", obj.Kind, obj.Name, err)
+	}
+
+	return resourceManifestRead(ctx, d, meta)
+}
+
+// flattenManagedFields only keeps the fields of the live object which are declared in the manifest, so that the drift
+// of the managed fields can be detected without being affected by the fields set by the server.
+func flattenManagedFields(desired, live interface{}) interface{} {
+	switch desiredVal := desired.(type) {
+	case map[string]interface{}:
+		liveVal, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		result := make(map[string]interface{})
+		for k, v := range desiredVal {
+			if lv, ok := liveVal[k]; ok {
+				result[k] = flattenManagedFields(v, lv)
+			}
+		}
+		return result
+	case []interface{}:
+		liveVal, ok := live.([]interface{})
+		if !ok {
+			return live
+		}
+		return flattenManagedListItems(desiredVal, liveVal)
+	}
+	return live
+}
+
+// The keys which identify the items of the lists in the Kubernetes objects, e.g. the containers and the ports.
+var manifestListMergeKeys = []string{"name", "containerPort", "port", "mountPath", "devicePath", "type", "ip"}
+
+// getManifestListMergeKey returns the merge key which is declared with the unique values by all desired items.
+func getManifestListMergeKey(items []interface{}) string {
+	for _, key := range manifestListMergeKeys {
+		values := make(map[interface{}]bool, len(items))
+		for _, item := range items {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				return ""
+			}
+			value, ok := itemMap[key]
+			if !ok || values[value] {
+				break
+			}
+			values[value] = true
+		}
+		if len(values) == len(items) {
+			return key
+		}
+	}
+	return ""
+}
+
+// flattenManagedListItems matches the live items with the desired items by the merge key, or by the index if there is
+// no merge key. The live items which are not declared in the manifest, such as the items added by the server, are
+// dropped, and the desired items which are not found in the live list are missing in the result.
+func flattenManagedListItems(desired, live []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(desired))
+	mergeKey := getManifestListMergeKey(desired)
+	if mergeKey == "" {
+		for i, v := range desired {
+			if i >= len(live) {
+				break
+			}
+			result = append(result, flattenManagedFields(v, live[i]))
+		}
+		return result
+	}
+
+	for _, v := range desired {
+		value := v.(map[string]interface{})[mergeKey]
+		for _, lv := range live {
+			if liveItem, ok := lv.(map[string]interface{}); ok && reflect.DeepEqual(liveItem[mergeKey], value) {
+				result = append(result, flattenManagedFields(v, lv))
+				break
+			}
+		}
+	}
+	return result
+}
+
+func resourceManifestRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	obj, err := parseManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path, err := getManifestObjectPath(client, d.Get("cluster_id").(string), obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	respBody, err := getManifestLiveObject(client, path)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the object of CCE manifest")
+	}
+	if utils.PathSearch("metadata.uid", respBody, "").(string) != d.Id() {
+		log.Printf("[WARN] the object (%s/%s) has been recreated outside, remove it from the state",
+			obj.Kind, obj.Name)
+		d.SetId("")
+		return nil
+	}
+
+	liveManifest, err := json.Marshal(flattenManagedFields(obj.Body, respBody))
+	if err != nil {
+		return diag.Errorf("error converting the live object to JSON: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("manifest", string(liveManifest)),
+		d.Set("api_version", utils.PathSearch("apiVersion", respBody, nil)),
+		d.Set("kind", utils.PathSearch("kind", respBody, nil)),
+		d.Set("name", utils.PathSearch("metadata.name", respBody, nil)),
+		d.Set("namespace", utils.PathSearch("metadata.namespace", respBody, nil)),
+		d.Set("resource_version", utils.PathSearch("metadata.resourceVersion", respBody, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// resourceManifestImportState imports the object by the ID in the format of
+// <cluster_id>/<api_version>/<kind>/<namespace>/<name>, the namespace is empty for the cluster-scoped objects.
+func resourceManifestImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	// The API version of the objects in the API groups contains a slash, e.g. apps/v1.
+	if len(parts) != 5 && len(parts) != 6 {
+		return nil, fmt.Errorf("invalid format specified for import ID, want "+
+			"'<cluster_id>/<api_version>/<kind>/<namespace>/<name>', but got '%s'", d.Id())
+	}
+	n := len(parts)
+	obj := &manifestObject{
+		ApiVersion: strings.Join(parts[1:n-3], "/"),
+		Kind:       parts[n-3],
+		Namespace:  parts[n-2],
+		Name:       parts[n-1],
+	}
+	metadata := map[string]interface{}{
+		"name": obj.Name,
+	}
+	if obj.Namespace != "" {
+		metadata["namespace"] = obj.Namespace
+	}
+	obj.Body = map[string]interface{}{
+		"apiVersion": obj.ApiVersion,
+		"kind":       obj.Kind,
+		"metadata":   metadata,
+	}
+
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v1 client: %s", err)
+	}
+	path, err := getManifestObjectPath(client, parts[0], obj)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := getManifestLiveObject(client, path)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the object (%s/%s): %s", obj.Kind, obj.Name, err)
+	}
+	uid := utils.PathSearch("metadata.uid", respBody, "").(string)
+	if uid == "" {
+		return nil, fmt.Errorf("unable to find the UID of the object (%s/%s)", obj.Kind, obj.Name)
+	}
+
+	// Only the identity of the object is imported to the manifest, the fields declared in the configuration are
+	// applied by the next apply.
+	manifest, err := json.Marshal(obj.Body)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(uid)
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", parts[0]),
+		d.Set("manifest", string(manifest)),
+		d.Set("field_manager", "terraform"),
+		d.Set("deletion_propagation", "Background"),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
+
+func resourceManifestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	if d.HasChanges("manifest", "field_manager", "force_conflicts", "wait_for") {
+		obj, err := parseManifest(d.Get("manifest").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		path, err := getManifestObjectPath(client, d.Get("cluster_id").(string), obj)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err = applyManifest(client, d, path, obj); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = waitForManifestConditions(ctx, d, client, path, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for the object (%s/%s) to become ready: %s", obj.Kind, obj.Name, err)
+		}
+	}
+
+	return resourceManifestRead(ctx, d, meta)
+}
+
+func resourceManifestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	obj, err := parseManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	path, err := getManifestObjectPath(client, d.Get("cluster_id").(string), obj)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"kind":              "DeleteOptions",
+			"apiVersion":        "v1",
+			"propagationPolicy": d.Get("deletion_propagation").(string),
+		},
+	}
+	_, err = client.Request("DELETE", path, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting the object of CCE manifest")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			respBody, err := getManifestLiveObject(client, path)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return respBody, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the object (%s/%s) to be deleted: %s", obj.Kind, obj.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package cce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenManagedFieldsWithExtendedLists(t *testing.T) {
+	desired, err := parseManifest(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          image: nginx:1.25
+          ports:
+            - containerPort: 80
+          args: ["--port", "80"]
+`)
+	assert.NoError(t, err)
+
+	// The server defaults the fields of the container, extends the ports with the protocol and adds a sidecar
+	// container at the front of the list.
+	live, err := parseManifest(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+  uid: 3c2b5b0e-d0c5-4c2b-9c61-1b2d6f3f0d7a
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+        - name: sidecar
+          image: envoy:1.29
+        - name: app
+          image: nginx:1.25
+          imagePullPolicy: IfNotPresent
+          ports:
+            - containerPort: 8080
+              protocol: TCP
+            - containerPort: 80
+              protocol: TCP
+          args: ["--port", "80", "--verbose"]
+`)
+	assert.NoError(t, err)
+
+	assert.Equal(t, desired.Body, flattenManagedFields(desired.Body, live.Body))
+}
+
+func TestFlattenManagedFieldsWithDrift(t *testing.T) {
+	desired, err := parseManifest(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: test
+spec:
+  ports:
+    - name: http
+      port: 80
+    - name: https
+      port: 443
+`)
+	assert.NoError(t, err)
+
+	// The https port is removed and the http port is changed outside.
+	live, err := parseManifest(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: test
+spec:
+  ports:
+    - name: http
+      port: 8080
+      protocol: TCP
+`)
+	assert.NoError(t, err)
+
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name": "test",
+		},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{
+				map[string]interface{}{
+					"name": "http",
+					"port": float64(8080),
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, flattenManagedFields(desired.Body, live.Body))
+}
@@ -26,6 +26,13 @@ import (
 // @API CCE DELETE /api/v3/projects/{project_id}/clusters/{clusterid}/nodepools/{nodepoolid}
 // @API CCE GET /api/v3/projects/{project_id}/clusters/{clusterid}/nodepools/{nodepoolid}
 // @API CCE PUT /api/v3/projects/{project_id}/clusters/{clusterid}/nodepools/{nodepoolid}
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{clusterid}/nodes
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{clusterid}/nodes/{nodeid}
+// @API CCE DELETE /api/v3/projects/{project_id}/clusters/{clusterid}/nodes/{nodeid}
+// @API CCE GET /api/v3/projects/{project_id}/clusters/{clusterid}/nodepools/{nodepoolid}/nodes
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{clusterid}/nodes/reset
+// @API CCE POST /api/v3/projects/{project_id}/clusters/{clusterid}/nodes/operation/drain
+// @API CCE GET /api/v3/projects/{project_id}/jobs/{job_id}
 func ResourceNodePool() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNodePoolCreate,
@@ -37,8 +44,11 @@ func ResourceNodePool() *schema.Resource {
 			StateContext: resourceNodePoolImport,
 		},
 
+		CustomizeDiff: resourceNodePoolCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -65,7 +75,6 @@ func ResourceNodePool() *schema.Resource {
 			"flavor_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -89,7 +98,6 @@ func ResourceNodePool() *schema.Resource {
 			"os": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"key_pair": {
@@ -134,15 +142,44 @@ func ResourceNodePool() *schema.Resource {
 			"runtime": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"docker", "containerd",
 				}, false),
 			},
-			"extend_params": resourceNodeExtendParamsSchema([]string{
-				"max_pods", "preinstall", "postinstall", "extend_param",
-			}),
+			"extend_params": resourceNodePoolExtendParamsSchema(),
+			"rolling_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_surge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"drain_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"drain_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -240,6 +277,39 @@ func ResourceNodePool() *schema.Resource {
 	}
 }
 
+// rollingUpdateParams are the parameters which can be updated by replacing the nodes one batch at a time.
+var rollingUpdateParams = []string{
+	"flavor_id", "os", "runtime", "extend_params.0.preinstall", "extend_params.0.postinstall",
+}
+
+func resourceNodePoolExtendParamsSchema() *schema.Schema {
+	extendParamsSchema := resourceNodeExtendParamsSchema([]string{
+		"max_pods", "preinstall", "postinstall", "extend_param",
+	})
+	// The scripts can be updated through the rolling update, the ForceNew behavior is controlled by CustomizeDiff.
+	elem := extendParamsSchema.Elem.(*schema.Resource)
+	elem.Schema["preinstall"].ForceNew = false
+	elem.Schema["postinstall"].ForceNew = false
+	return extendParamsSchema
+}
+
+// resourceNodePoolCustomizeDiff keeps the parameters which support rolling update as ForceNew unless the rolling
+// update is configured.
+func resourceNodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || len(d.Get("rolling_update").([]interface{})) > 0 {
+		return nil
+	}
+
+	for _, key := range rollingUpdateParams {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func buildPodSecurityGroups(ids []interface{}) []nodepools.PodSecurityGroupSpec {
 	if len(ids) == 0 {
 		return nil
@@ -477,6 +547,17 @@ func resourceNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	clusterId := d.Get("cluster_id").(string)
 	nodePoolId := d.Id()
+
+	// Record the nodes before the node template is updated, they will be replaced by the rolling update.
+	var oldNodes []nodes.Nodes
+	if d.HasChanges(rollingUpdateParams...) {
+		oldNodes, err = listNodePoolNodes(cceClient, clusterId, nodePoolId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		buildNodePoolRollingUpdateTemplate(d, updateOpts)
+	}
+
 	_, err = nodepools.Update(cceClient, clusterId, nodePoolId, updateOpts).Extract()
 	if err != nil {
 		return diag.Errorf("error updating CCE node pool (%s): %s", nodePoolId, err)
@@ -496,6 +577,14 @@ func resourceNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error waiting for CCE node pool (%s) to become available: %s", nodePoolId, err)
 	}
 
+	if len(oldNodes) > 0 {
+		if err = rollingUpdateNodePool(ctx, d, cceClient, oldNodes); err != nil {
+			// Keep the old values in the state so that the remaining nodes can be updated in the next apply.
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNodePoolRead(ctx, d, meta)
 }
 
@@ -569,3 +658,310 @@ func resourceNodePoolImport(_ context.Context, d *schema.ResourceData, _ interfa
 	err := d.Set("cluster_id", clusterID)
 	return []*schema.ResourceData{d}, err
 }
+
+func listNodePoolNodes(client *golangsdk.ServiceClient, clusterId, nodePoolId string) ([]nodes.Nodes, error) {
+	listHttpUrl := "api/v3/projects/{project_id}/clusters/{cluster_id}/nodepools/{nodepool_id}/nodes"
+	listPath := client.Endpoint + listHttpUrl
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{cluster_id}", clusterId)
+	listPath = strings.ReplaceAll(listPath, "{nodepool_id}", nodePoolId)
+
+	var listResp struct {
+		Items []nodes.Nodes `json:"items"`
+	}
+	_, err := client.Get(listPath, &listResp, &golangsdk.RequestOpts{
+		MoreHeaders: nodes.RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the nodes of CCE node pool (%s): %s", nodePoolId, err)
+	}
+	return listResp.Items, nil
+}
+
+func buildNodePoolRollingUpdateTemplate(d *schema.ResourceData, updateOpts *nodepools.UpdateOpts) {
+	updateOpts.Spec.NodeTemplate.Flavor = d.Get("flavor_id").(string)
+	updateOpts.Spec.NodeTemplate.Os = d.Get("os").(string)
+	updateOpts.Spec.NodeTemplate.ExtendParam = buildExtendParams(d)
+	if v, ok := d.GetOk("runtime"); ok {
+		updateOpts.Spec.NodeTemplate.RunTime = &nodes.RunTimeSpec{
+			Name: v.(string),
+		}
+	}
+}
+
+func updateNodePoolNodeCount(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	count int) error {
+	clusterId := d.Get("cluster_id").(string)
+	nodePoolId := d.Id()
+	updateOpts, err := buildNodePoolUpdateOpts(d)
+	if err != nil {
+		return err
+	}
+	buildNodePoolRollingUpdateTemplate(d, updateOpts)
+	updateOpts.Spec.InitialNodeCount = utils.Int(count)
+
+	_, err = nodepools.Update(client, clusterId, nodePoolId, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error scaling CCE node pool (%s) to %d nodes: %s", nodePoolId, count, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			poolNodes, err := listNodePoolNodes(client, clusterId, nodePoolId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if len(poolNodes) != count {
+				return poolNodes, "PENDING", nil
+			}
+			for _, node := range poolNodes {
+				if node.Status.Phase == "Error" {
+					return poolNodes, "ERROR", fmt.Errorf("the node (%s) is in error status", node.Metadata.Id)
+				}
+				if node.Status.Phase != "Active" {
+					return poolNodes, "PENDING", nil
+				}
+			}
+			return poolNodes, "COMPLETED", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        60 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for CCE node pool (%s) to be scaled to %d nodes: %s", nodePoolId, count, err)
+	}
+	return nil
+}
+
+// drainNodes evicts the pods from the nodes before they are replaced.
+func drainNodes(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, nodeIds []string) error {
+	if !d.Get("rolling_update.0.drain_enabled").(bool) {
+		return nil
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	drainHttpUrl := "api/v3/projects/{project_id}/clusters/{cluster_id}/nodes/operation/drain"
+	drainPath := client.Endpoint + drainHttpUrl
+	drainPath = strings.ReplaceAll(drainPath, "{project_id}", client.ProjectID)
+	drainPath = strings.ReplaceAll(drainPath, "{cluster_id}", clusterId)
+	drainOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "DrainNodesTask",
+			"spec": map[string]interface{}{
+				"nodes":              nodeIds,
+				"dryRun":             false,
+				"ignoreDaemonSets":   true,
+				"deleteEmptyDirData": true,
+				"timeoutSeconds":     d.Get("rolling_update.0.drain_timeout").(int),
+			},
+		},
+	}
+	drainResp, err := client.Request("POST", drainPath, &drainOpt)
+	if err != nil {
+		return fmt.Errorf("error draining nodes (%v): %s", nodeIds, err)
+	}
+	drainRespBody, err := utils.FlattenResponse(drainResp)
+	if err != nil {
+		return err
+	}
+
+	jobId := utils.PathSearch("status.jobID", drainRespBody, "").(string)
+	if jobId == "" {
+		return fmt.Errorf("unable to find the drain job ID of nodes (%v)", nodeIds)
+	}
+	stateJob := &resource.StateChangeConf{
+		Pending:      []string{"Initializing", "Running"},
+		Target:       []string{"Success"},
+		Refresh:      waitForJobStatus(client, jobId),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateJob.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for nodes (%v) to be drained: %s", nodeIds, err)
+	}
+	return nil
+}
+
+func buildNodePoolResetOpts(d *schema.ResourceData, nodeIds []string) (*nodes.ResetOpts, error) {
+	loginSpec, err := buildResourceNodeLoginSpec(d)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := nodes.AddNodeSpec{
+		Os:    d.Get("os").(string),
+		Login: loginSpec,
+		K8sOptions: &nodes.K8sOptions{
+			Labels: buildResourceNodeK8sTags(d),
+			Taints: buildResourceNodeTaint(d),
+		},
+		InitializedConditions: utils.ExpandToStringList(d.Get("initialized_conditions").([]interface{})),
+	}
+	if v, ok := d.GetOk("runtime"); ok {
+		spec.RuntimeConfig = &nodes.RuntimeConfig{
+			Runtime: &nodes.RunTimeSpec{
+				Name: v.(string),
+			},
+		}
+	}
+	preinstall := d.Get("extend_params.0.preinstall").(string)
+	postinstall := d.Get("extend_params.0.postinstall").(string)
+	if preinstall != "" || postinstall != "" {
+		spec.Lifecycle = &nodes.Lifecycle{
+			Preinstall:  utils.TryBase64EncodeString(preinstall),
+			PostInstall: utils.TryBase64EncodeString(postinstall),
+		}
+	}
+
+	result := nodes.ResetOpts{
+		Kind:       "List",
+		ApiVersion: "v3",
+		NodeList:   make([]nodes.ResetNode, len(nodeIds)),
+	}
+	for i, nodeId := range nodeIds {
+		result.NodeList[i] = nodes.ResetNode{
+			NodeID: nodeId,
+			Spec:   spec,
+		}
+	}
+	return &result, nil
+}
+
+// resetNodes reinstalls the nodes with the new OS, runtime and scripts, the nodes keep their flavors.
+func resetNodes(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, nodeIds []string) error {
+	clusterId := d.Get("cluster_id").(string)
+	resetOpts, err := buildNodePoolResetOpts(d, nodeIds)
+	if err != nil {
+		return err
+	}
+	resp, err := nodes.Reset(client, clusterId, resetOpts).ExtractAddNode()
+	if err != nil {
+		return fmt.Errorf("error resetting nodes (%v): %s", nodeIds, err)
+	}
+
+	stateJob := &resource.StateChangeConf{
+		Pending:      []string{"Initializing", "Running"},
+		Target:       []string{"Success"},
+		Refresh:      waitForJobStatus(client, resp.JobID),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        60 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err = stateJob.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the reset job (%s) of nodes (%v) to complete: %s", resp.JobID, nodeIds, err)
+	}
+
+	for _, nodeId := range nodeIds {
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"PENDING"},
+			Target:       []string{"COMPLETED"},
+			Refresh:      nodeStateRefreshFunc(client, clusterId, nodeId, []string{"Active"}),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        10 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for node (%s) to become active: %s", nodeId, err)
+		}
+	}
+	return nil
+}
+
+// replaceNodes creates the new nodes with the node template of the node pool first, and then removes the old nodes.
+func replaceNodes(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, nodeIds []string) error {
+	clusterId := d.Get("cluster_id").(string)
+	count := d.Get("initial_node_count").(int)
+	if err := updateNodePoolNodeCount(ctx, d, client, count+len(nodeIds)); err != nil {
+		return err
+	}
+
+	if err := drainNodes(ctx, d, client, nodeIds); err != nil {
+		return err
+	}
+
+	for _, nodeId := range nodeIds {
+		if err := nodes.Delete(client, clusterId, nodeId).ExtractErr(); err != nil {
+			return fmt.Errorf("error deleting node (%s): %s", nodeId, err)
+		}
+	}
+	for _, nodeId := range nodeIds {
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"PENDING"},
+			Target:       []string{"COMPLETED"},
+			Refresh:      nodeStateRefreshFunc(client, clusterId, nodeId, nil),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        60 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for node (%s) to be deleted: %s", nodeId, err)
+		}
+	}
+
+	return updateNodePoolNodeCount(ctx, d, client, count)
+}
+
+// isNodeMatchNodePoolTemplate checks whether the node has already been updated to the new node template.
+// The scripts can not be read back from the node, so the nodes are always updated if the scripts are changed.
+func isNodeMatchNodePoolTemplate(d *schema.ResourceData, node nodes.Nodes) bool {
+	if d.HasChanges("extend_params.0.preinstall", "extend_params.0.postinstall") {
+		return false
+	}
+	if node.Spec.Flavor != d.Get("flavor_id").(string) || node.Spec.Os != d.Get("os").(string) {
+		return false
+	}
+	if v, ok := d.GetOk("runtime"); ok && (node.Spec.RunTime == nil || node.Spec.RunTime.Name != v.(string)) {
+		return false
+	}
+	return true
+}
+
+// rollingUpdateNodePool updates the existing nodes of the node pool one batch at a time. If the flavor is changed,
+// the nodes are replaced with new nodes (at most max_surge nodes per batch), otherwise the nodes are reset in place
+// (at most max_unavailable nodes per batch).
+func rollingUpdateNodePool(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	oldNodes []nodes.Nodes) error {
+	batchSize := d.Get("rolling_update.0.max_unavailable").(int)
+	updateFunc := func(nodeIds []string) error {
+		if err := drainNodes(ctx, d, client, nodeIds); err != nil {
+			return err
+		}
+		return resetNodes(ctx, d, client, nodeIds)
+	}
+	if d.HasChange("flavor_id") {
+		batchSize = d.Get("rolling_update.0.max_surge").(int)
+		updateFunc = func(nodeIds []string) error {
+			return replaceNodes(ctx, d, client, nodeIds)
+		}
+	}
+
+	nodeIds := make([]string, 0, len(oldNodes))
+	for _, node := range oldNodes {
+		// Skip the nodes which have been updated by the previous apply.
+		if isNodeMatchNodePoolTemplate(d, node) {
+			continue
+		}
+		nodeIds = append(nodeIds, node.Metadata.Id)
+	}
+
+	for start := 0; start < len(nodeIds); start += batchSize {
+		end := start + batchSize
+		if end > len(nodeIds) {
+			end = len(nodeIds)
+		}
+
+		log.Printf("[DEBUG] Rolling update the nodes (%v) of CCE node pool (%s)", nodeIds[start:end], d.Id())
+		if err := updateFunc(nodeIds[start:end]); err != nil {
+			return fmt.Errorf("the rolling update of CCE node pool (%s) failed.\n"+
+				"updated nodes: %v\nfailed nodes: %v\nnot updated nodes: %v\nerror: %s",
+				d.Id(), nodeIds[:start], nodeIds[start:end], nodeIds[end:], err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The node pool scale policies are saved as the HorizontalNodeAutoscaler objects in the cluster,
+// which are handled by the autoscaler add-on.
+const scalePolicyHttpUrl = "apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers"
+
+// @API CCE POST /apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers
+// @API CCE GET /apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers/{name}
+// @API CCE PATCH /apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers/{name}
+// @API CCE DELETE /apis/autoscaling.cce.io/v1alpha1/namespaces/kube-system/horizontalnodeautoscalers/{name}
+func ResourceNodePoolScalePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNodePoolScalePolicyCreate,
+		ReadContext:   resourceNodePoolScalePolicyRead,
+		UpdateContext: resourceNodePoolScalePolicyUpdate,
+		DeleteContext: resourceNodePoolScalePolicyDelete,
+
+		CustomizeDiff: resourceNodePoolScalePolicyCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNodePoolScalePolicyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_pool_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     scalePolicyRuleSchema(),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func scalePolicyRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Metric", "Cron"}, false),
+			},
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Node",
+							ValidateFunc: validation.StringInSlice([]string{"Node", "Percent"}, false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ScaleUp",
+							ValidateFunc: validation.StringInSlice([]string{"ScaleUp"}, false),
+						},
+					},
+				},
+			},
+			"metric_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Cpu", "Memory"}, false),
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ">",
+							ValidateFunc: validation.StringInSlice([]string{">", "<"}, false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
+			"cron_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceNodePoolScalePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for i, v := range d.Get("rules").([]interface{}) {
+		rule, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch rule["type"].(string) {
+		case "Metric":
+			if len(rule["metric_trigger"].([]interface{})) == 0 || len(rule["cron_trigger"].([]interface{})) != 0 {
+				return fmt.Errorf("rules.%d: only metric_trigger must be specified for the Metric rule", i)
+			}
+		case "Cron":
+			if len(rule["cron_trigger"].([]interface{})) == 0 || len(rule["metric_trigger"].([]interface{})) != 0 {
+				return fmt.Errorf("rules.%d: only cron_trigger must be specified for the Cron rule", i)
+			}
+		}
+	}
+	return nil
+}
+
+func buildScalePolicyRulesBodyParams(rules []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		action := rule["action"].([]interface{})[0].(map[string]interface{})
+		params := map[string]interface{}{
+			"ruleName": rule["name"],
+			"type":     rule["type"],
+			"disable":  !rule["enabled"].(bool),
+			"action": map[string]interface{}{
+				"type":  action["type"],
+				"unit":  action["unit"],
+				"value": action["value"],
+			},
+		}
+		if triggers := rule["metric_trigger"].([]interface{}); len(triggers) > 0 {
+			trigger := triggers[0].(map[string]interface{})
+			params["metricTrigger"] = map[string]interface{}{
+				"metricName":      trigger["metric_name"],
+				"metricOperation": trigger["operator"],
+				"metricValue":     fmt.Sprint(trigger["value"]),
+				"unit":            "Percent",
+			}
+		}
+		if triggers := rule["cron_trigger"].([]interface{}); len(triggers) > 0 {
+			trigger := triggers[0].(map[string]interface{})
+			params["cronTrigger"] = map[string]interface{}{
+				"schedule": trigger["schedule"],
+			}
+		}
+		result = append(result, params)
+	}
+	return result
+}
+
+func buildScalePolicySpecBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"disable":           !d.Get("enabled").(bool),
+		"targetNodepoolIds": utils.ExpandToStringListBySet(d.Get("node_pool_ids").(*schema.Set)),
+		"rules":             buildScalePolicyRulesBodyParams(d.Get("rules").([]interface{})),
+	}
+}
+
+func buildScalePolicyPath(client *golangsdk.ServiceClient, clusterId string) (string, error) {
+	baseUrl, err := buildClusterApiServerURL(client, clusterId)
+	if err != nil {
+		return "", err
+	}
+	return baseUrl + scalePolicyHttpUrl, nil
+}
+
+func resourceNodePoolScalePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	createPath, err := buildScalePolicyPath(client, d.Get("cluster_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"apiVersion": "autoscaling.cce.io/v1alpha1",
+			"kind":       "HorizontalNodeAutoscaler",
+			"metadata": map[string]interface{}{
+				"name":      d.Get("name"),
+				"namespace": "kube-system",
+			},
+			"spec": buildScalePolicySpecBodyParams(d),
+		},
+		OkCodes: []int{200, 201},
+	}
+	_, err = client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CCE node pool scale policy: %s", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceNodePoolScalePolicyRead(ctx, d, meta)
+}
+
+func flattenScalePolicyRules(rules []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		params := map[string]interface{}{
+			"name":    utils.PathSearch("ruleName", rule, nil),
+			"type":    utils.PathSearch("type", rule, nil),
+			"enabled": !utils.PathSearch("disable", rule, false).(bool),
+			"action": []map[string]interface{}{
+				{
+					"type":  utils.PathSearch("action.type", rule, nil),
+					"unit":  utils.PathSearch("action.unit", rule, nil),
+					"value": utils.PathSearch("action.value", rule, nil),
+				},
+			},
+		}
+		if trigger := utils.PathSearch("metricTrigger", rule, nil); trigger != nil {
+			// The metric value is a string in the object.
+			value, _ := strconv.Atoi(utils.PathSearch("metricValue", trigger, "").(string))
+			params["metric_trigger"] = []map[string]interface{}{
+				{
+					"metric_name": utils.PathSearch("metricName", trigger, nil),
+					"operator":    utils.PathSearch("metricOperation", trigger, nil),
+					"value":       value,
+				},
+			}
+		}
+		if trigger := utils.PathSearch("cronTrigger", rule, nil); trigger != nil {
+			params["cron_trigger"] = []map[string]interface{}{
+				{
+					"schedule": utils.PathSearch("schedule", trigger, nil),
+				},
+			}
+		}
+		result = append(result, params)
+	}
+	return result
+}
+
+func resourceNodePoolScalePolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	getPath, err := buildScalePolicyPath(client, d.Get("cluster_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath+"/"+d.Id(), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE node pool scale policy")
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("metadata.name", getRespBody, nil)),
+		d.Set("node_pool_ids", utils.PathSearch("spec.targetNodepoolIds", getRespBody, nil)),
+		d.Set("rules", flattenScalePolicyRules(utils.PathSearch("spec.rules", getRespBody,
+			make([]interface{}, 0)).([]interface{}))),
+		d.Set("enabled", !utils.PathSearch("spec.disable", getRespBody, false).(bool)),
+		d.Set("created_at", utils.PathSearch("metadata.creationTimestamp", getRespBody, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceNodePoolScalePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	updatePath, err := buildScalePolicyPath(client, d.Get("cluster_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The JSON merge patch replaces the lists entirely, so the removed rules and node pools are also updated.
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"spec": buildScalePolicySpecBodyParams(d),
+		},
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/merge-patch+json",
+		},
+	}
+	_, err = client.Request("PATCH", updatePath+"/"+d.Id(), &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating CCE node pool scale policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceNodePoolScalePolicyRead(ctx, d, meta)
+}
+
+func resourceNodePoolScalePolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	deletePath, err := buildScalePolicyPath(client, d.Get("cluster_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	_, err = client.Request("DELETE", deletePath+"/"+d.Id(), &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE node pool scale policy")
+	}
+	return nil
+}
+
+func resourceNodePoolScalePolicyImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format of import ID, want '<cluster_id>/<name>', but got '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
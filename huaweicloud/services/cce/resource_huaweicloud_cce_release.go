@@ -0,0 +1,676 @@
+package cce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API CCE GET /v2/charts
+// @API CCE POST /cce/cam/v3/clusters/{cluster_id}/releases
+// @API CCE GET /cce/cam/v3/clusters/{cluster_id}/namespace/{namespace}/releases/{name}
+// @API CCE PUT /cce/cam/v3/clusters/{cluster_id}/namespace/{namespace}/releases/{name}
+// @API CCE DELETE /cce/cam/v3/clusters/{cluster_id}/namespace/{namespace}/releases/{name}
+func ResourceRelease() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceReleaseCreate,
+		ReadContext:   resourceReleaseRead,
+		UpdateContext: resourceReleaseUpdate,
+		DeleteContext: resourceReleaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceReleaseImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+			},
+			"chart_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"chart_id", "chart_name"},
+			},
+			"chart_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"chart_version"},
+			},
+			"chart_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"values": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateReleaseValues,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return isReleaseValuesEquivalent(old, new)
+				},
+			},
+			"set_values": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"atomic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+			},
+			"rollback_revision": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"chart_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func parseReleaseValues(values string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if values == "" {
+		return result, nil
+	}
+
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(values), &raw); err != nil {
+		return nil, fmt.Errorf("error parsing the release values: %s", err)
+	}
+	normalized, err := normalizeManifestValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	if normalized == nil {
+		return result, nil
+	}
+	result, ok := normalized.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the release values must be a map")
+	}
+	return result, nil
+}
+
+func validateReleaseValues(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseReleaseValues(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %s", k, err))
+	}
+	return
+}
+
+func isReleaseValuesEquivalent(old, new string) bool {
+	oldValues, err := parseReleaseValues(old)
+	if err != nil {
+		return false
+	}
+	newValues, err := parseReleaseValues(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValues, newValues)
+}
+
+// buildReleaseValues merges the set_values over the values, the keys of set_values are the paths separated by dots
+// and the values are parsed in the same way as the YAML scalars.
+func buildReleaseValues(d *schema.ResourceData) (map[string]interface{}, error) {
+	result, err := parseReleaseValues(d.Get("values").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	for path, v := range d.Get("set_values").(map[string]interface{}) {
+		value, err := parseReleaseSetValue(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		setReleaseValueByPath(result, path, value)
+	}
+	return result, nil
+}
+
+func parseReleaseSetValue(v string) (interface{}, error) {
+	var value interface{}
+	if err := yaml.Unmarshal([]byte(v), &value); err != nil || value == nil {
+		value = v
+	}
+	return normalizeManifestValue(value)
+}
+
+func setReleaseValueByPath(values map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	current := values
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[key] = next
+		}
+		current = next
+	}
+	current[keys[len(keys)-1]] = value
+}
+
+func getReleaseValueByPath(values map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	current := values
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	value, ok := current[keys[len(keys)-1]]
+	return value, ok
+}
+
+func deleteReleaseValueByPath(values map[string]interface{}, path string) {
+	keys := strings.Split(path, ".")
+	current := values
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		current = next
+	}
+	delete(current, keys[len(keys)-1])
+}
+
+// flattenReleaseValues splits the values returned by the API into values and set_values. Only the paths already in
+// set_values are read back into set_values, and the original string is kept if it is equivalent to the remote value.
+func flattenReleaseValues(d *schema.ResourceData, respBody interface{}) (string, map[string]interface{}, error) {
+	var remoteValues map[string]interface{}
+	switch v := utils.PathSearch("values", respBody, nil).(type) {
+	case string:
+		parsed, err := parseReleaseValues(v)
+		if err != nil {
+			return "", nil, err
+		}
+		remoteValues = parsed
+	case map[string]interface{}:
+		normalized, err := normalizeManifestValue(v)
+		if err != nil {
+			return "", nil, err
+		}
+		remoteValues = normalized.(map[string]interface{})
+	default:
+		remoteValues = make(map[string]interface{})
+	}
+
+	// The values in the state are used to restore the paths which are overridden by set_values.
+	stateValues, err := parseReleaseValues(d.Get("values").(string))
+	if err != nil {
+		stateValues = make(map[string]interface{})
+	}
+
+	setValues := make(map[string]interface{})
+	for path, v := range d.Get("set_values").(map[string]interface{}) {
+		remoteValue, ok := getReleaseValueByPath(remoteValues, path)
+		if !ok {
+			continue
+		}
+		if localValue, err := parseReleaseSetValue(v.(string)); err == nil && reflect.DeepEqual(localValue, remoteValue) {
+			setValues[path] = v
+		} else {
+			setValues[path] = formatReleaseSetValue(remoteValue)
+		}
+
+		if stateValue, ok := getReleaseValueByPath(stateValues, path); ok {
+			setReleaseValueByPath(remoteValues, path, stateValue)
+		} else {
+			deleteReleaseValueByPath(remoteValues, path)
+		}
+	}
+
+	if len(remoteValues) == 0 {
+		return "", setValues, nil
+	}
+	// Keep the original format of the values if the content is not changed.
+	if originValues := d.Get("values").(string); reflect.DeepEqual(stateValues, remoteValues) {
+		return originValues, setValues, nil
+	}
+	values, err := yaml.Marshal(remoteValues)
+	if err != nil {
+		return "", nil, fmt.Errorf("error converting the release values to YAML: %s", err)
+	}
+	return string(values), setValues, nil
+}
+
+func formatReleaseSetValue(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		return string(b)
+	}
+}
+
+func buildReleasePath(client *golangsdk.ServiceClient, d *schema.ResourceData) string {
+	releasePath := client.Endpoint + "cce/cam/v3/clusters/{cluster_id}/namespace/{namespace}/releases/{name}"
+	releasePath = strings.ReplaceAll(releasePath, "{cluster_id}", d.Get("cluster_id").(string))
+	releasePath = strings.ReplaceAll(releasePath, "{namespace}", d.Get("namespace").(string))
+	releasePath = strings.ReplaceAll(releasePath, "{name}", d.Get("name").(string))
+	return releasePath
+}
+
+// getReleaseChartId returns the chart ID, the chart is specified by ID or by name and version.
+// The charts in the public repository are also returned by the list API.
+func getReleaseChartId(client *golangsdk.ServiceClient, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("chart_id"); ok && !d.HasChanges("chart_name", "chart_version") {
+		return v.(string), nil
+	}
+
+	listPath := client.Endpoint + "v2/charts"
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	listResp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving CCE charts: %s", err)
+	}
+	listRespBody, err := utils.FlattenResponse(listResp)
+	if err != nil {
+		return "", err
+	}
+
+	chartName := d.Get("chart_name").(string)
+	chartVersion := d.Get("chart_version").(string)
+	expression := fmt.Sprintf("[?name=='%s' && version=='%s']|[0].id", chartName, chartVersion)
+	chartId := utils.PathSearch(expression, listRespBody, "").(string)
+	if chartId == "" {
+		return "", fmt.Errorf("unable to find the CCE chart (%s) of version %s", chartName, chartVersion)
+	}
+	return chartId, nil
+}
+
+func getRelease(client *golangsdk.ServiceClient, releasePath string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", releasePath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func releaseStatusRefreshFunc(client *golangsdk.ServiceClient, releasePath string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getRelease(client, releasePath)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		status := utils.PathSearch("status", respBody, "").(string)
+		switch status {
+		case "DEPLOYED":
+			return respBody, "COMPLETED", nil
+		case "FAILED":
+			return respBody, "ERROR", fmt.Errorf("the release status is %s: %v", status,
+				utils.PathSearch("status_description", respBody, nil))
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForReleaseDeployed(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      releaseStatusRefreshFunc(client, buildReleasePath(client, d)),
+		Timeout:      time.Duration(d.Get("timeout").(int)) * time.Second,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func buildCreateReleaseBodyParams(d *schema.ResourceData, chartId string,
+	values map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"chart_id":    chartId,
+		"name":        d.Get("name"),
+		"namespace":   d.Get("namespace"),
+		"description": utils.ValueIngoreEmpty(d.Get("description")),
+		"values":      values,
+	}
+}
+
+func resourceReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	chartId, err := getReleaseChartId(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	values, err := buildReleaseValues(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createPath := client.Endpoint + "cce/cam/v3/clusters/{cluster_id}/releases"
+	createPath = strings.ReplaceAll(createPath, "{cluster_id}", d.Get("cluster_id").(string))
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildCreateReleaseBodyParams(d, chartId, values)),
+		OkCodes:          []int{200, 201},
+	}
+	_, err = client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CCE release: %s", err)
+	}
+	d.SetId(d.Get("name").(string))
+	// The chart ID is not returned by the query API, so it is saved after the release is installed.
+	if err = d.Set("chart_id", chartId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait").(bool) || d.Get("atomic").(bool) {
+		if err = waitForReleaseDeployed(ctx, client, d); err != nil {
+			if !d.Get("atomic").(bool) {
+				return diag.Errorf("error waiting for the CCE release (%s) to be deployed: %s", d.Id(), err)
+			}
+
+			log.Printf("[WARN] the CCE release (%s) installation failed, uninstall it because atomic is set", d.Id())
+			if delErr := deleteRelease(ctx, client, d); delErr != nil {
+				err = multierror.Append(err, delErr)
+			}
+			d.SetId("")
+			return diag.Errorf("error installing the CCE release atomically: %s", err)
+		}
+	}
+
+	return resourceReleaseRead(ctx, d, meta)
+}
+
+func resourceReleaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	respBody, err := getRelease(client, buildReleasePath(client, d))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE release")
+	}
+
+	values, setValues, err := flattenReleaseValues(d, respBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", respBody, nil)),
+		d.Set("namespace", utils.PathSearch("namespace", respBody, nil)),
+		d.Set("chart_name", utils.PathSearch("chart_name", respBody, nil)),
+		d.Set("chart_version", utils.PathSearch("chart_version", respBody, nil)),
+		d.Set("chart_public", utils.PathSearch("chart_public", respBody, nil)),
+		d.Set("description", utils.PathSearch("description", respBody, nil)),
+		d.Set("values", values),
+		d.Set("set_values", setValues),
+		d.Set("status", utils.PathSearch("status", respBody, nil)),
+		d.Set("status_description", utils.PathSearch("status_description", respBody, nil)),
+		d.Set("revision", utils.PathSearch("version", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("create_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("update_at", respBody, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func updateRelease(client *golangsdk.ServiceClient, d *schema.ResourceData, bodyParams map[string]interface{}) error {
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(bodyParams),
+	}
+	_, err := client.Request("PUT", buildReleasePath(client, d), &updateOpt)
+	return err
+}
+
+func rollbackRelease(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, chartId string,
+	revision int) error {
+	bodyParams := map[string]interface{}{
+		"chart_id": chartId,
+		"action":   "rollback",
+		"parameters": map[string]interface{}{
+			"release_version": revision,
+		},
+	}
+	if err := updateRelease(client, d, bodyParams); err != nil {
+		return fmt.Errorf("error rolling back CCE release (%s) to revision %d: %s", d.Id(), revision, err)
+	}
+	if err := waitForReleaseDeployed(ctx, client, d); err != nil {
+		return fmt.Errorf("error waiting for CCE release (%s) rollback to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func upgradeRelease(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	chartId string) error {
+	values, err := buildReleaseValues(d)
+	if err != nil {
+		return err
+	}
+
+	bodyParams := map[string]interface{}{
+		"chart_id": chartId,
+		"action":   "upgrade",
+		"parameters": map[string]interface{}{
+			"reset_values": true,
+		},
+		"values": values,
+	}
+	if err = updateRelease(client, d, bodyParams); err != nil {
+		return fmt.Errorf("error upgrading CCE release (%s): %s", d.Id(), err)
+	}
+
+	if !d.Get("wait").(bool) && !d.Get("atomic").(bool) {
+		return nil
+	}
+	if err = waitForReleaseDeployed(ctx, client, d); err != nil {
+		if !d.Get("atomic").(bool) {
+			return fmt.Errorf("error waiting for CCE release (%s) upgrade to complete: %s", d.Id(), err)
+		}
+
+		previousRevision, _ := d.GetChange("revision")
+		log.Printf("[WARN] the CCE release (%s) upgrade failed, roll back to revision %d because atomic is set",
+			d.Id(), previousRevision)
+		if rollbackErr := rollbackRelease(ctx, client, d, chartId, previousRevision.(int)); rollbackErr != nil {
+			err = multierror.Append(err, rollbackErr)
+		}
+		return fmt.Errorf("error upgrading the CCE release atomically: %s", err)
+	}
+	return nil
+}
+
+func resourceReleaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	chartId, err := getReleaseChartId(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("chart_id", "chart_name", "chart_version", "values", "set_values") {
+		if err = upgradeRelease(ctx, client, d, chartId); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		if err = d.Set("chart_id", chartId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("rollback_revision") {
+		if revision, ok := d.GetOk("rollback_revision"); ok {
+			if err = rollbackRelease(ctx, client, d, chartId, revision.(int)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceReleaseRead(ctx, d, meta)
+}
+
+func deleteRelease(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	releasePath := buildReleasePath(client, d)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	_, err := client.Request("DELETE", releasePath, &deleteOpt)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			respBody, err := getRelease(client, releasePath)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return respBody, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	if err = deleteRelease(ctx, client, d); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE release")
+	}
+	return nil
+}
+
+func resourceReleaseImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid format of import ID, want '<cluster_id>/<namespace>/<name>', but got '%s'",
+			d.Id())
+	}
+
+	d.SetId(parts[2])
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", parts[0]),
+		d.Set("namespace", parts[1]),
+		d.Set("name", parts[2]),
+		d.Set("wait", true),
+		d.Set("timeout", 300),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
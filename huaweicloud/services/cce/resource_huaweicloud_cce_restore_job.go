@@ -0,0 +1,420 @@
+package cce
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	backupRestoreResource         = "restores"
+	backupDownloadRequestResource = "downloadrequests"
+)
+
+// @API CCE POST /apis/velero.io/v1/namespaces/velero/restores
+// @API CCE GET /apis/velero.io/v1/namespaces/velero/restores/{name}
+// @API CCE DELETE /apis/velero.io/v1/namespaces/velero/restores/{name}
+// @API CCE POST /apis/velero.io/v1/namespaces/velero/downloadrequests
+// @API CCE GET /apis/velero.io/v1/namespaces/velero/downloadrequests/{name}
+// @API CCE DELETE /apis/velero.io/v1/namespaces/velero/downloadrequests/{name}
+func ResourceRestoreJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRestoreJobCreate,
+		ReadContext:   resourceRestoreJobRead,
+		DeleteContext: resourceRestoreJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"backup_plan_name"},
+			},
+			"backup_plan_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"included_namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"label_selector": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"namespace_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"restore_volumes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"existing_resource_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "update"}, false),
+			},
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_items": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"items_restored": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildRestoreJobSpec(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"backupName":             utils.ValueIngoreEmpty(d.Get("backup_name")),
+		"scheduleName":           utils.ValueIngoreEmpty(d.Get("backup_plan_name")),
+		"includedNamespaces":     utils.ExpandToStringListBySet(d.Get("included_namespaces").(*schema.Set)),
+		"excludedNamespaces":     utils.ExpandToStringListBySet(d.Get("excluded_namespaces").(*schema.Set)),
+		"labelSelector":          buildBackupLabelSelector(d.Get("label_selector").(map[string]interface{})),
+		"namespaceMapping":       utils.ValueIngoreEmpty(d.Get("namespace_mapping")),
+		"restorePVs":             d.Get("restore_volumes"),
+		"existingResourcePolicy": utils.ValueIngoreEmpty(d.Get("existing_resource_policy")),
+	}
+}
+
+func restoreJobStateRefreshFunc(client *golangsdk.ServiceClient, clusterId, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		restore, err := doBackupResourceRequest(client, "GET", clusterId, backupRestoreResource, name, nil)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		phase := utils.PathSearch("status.phase", restore, "").(string)
+		// The failed restore job is also a final state, its results are reported after waiting.
+		if utils.StrSliceContains([]string{"Completed", "PartiallyFailed", "Failed", "FailedValidation"}, phase) {
+			return restore, "COMPLETED", nil
+		}
+		return restore, "PENDING", nil
+	}
+}
+
+func resourceRestoreJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	var (
+		clusterId = d.Get("cluster_id").(string)
+		name      = d.Get("name").(string)
+	)
+	_, err = doBackupResourceRequest(client, "POST", clusterId, backupRestoreResource, "", map[string]interface{}{
+		"apiVersion": backupApiVersion,
+		"kind":       "Restore",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "velero",
+		},
+		"spec": utils.RemoveNil(buildRestoreJobSpec(d)),
+	})
+	if err != nil {
+		return diag.Errorf("error creating CCE restore job: %s", err)
+	}
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Waiting for CCE restore job (%s) to complete", name)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      restoreJobStateRefreshFunc(client, clusterId, name),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	restore, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for CCE restore job (%s) to complete: %s", name, err)
+	}
+
+	results, err := getRestoreJobResults(ctx, client, clusterId, name, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		// The results are only used for reporting, so the restore job is not treated as failed.
+		log.Printf("[WARN] unable to download the results of CCE restore job (%s): %s", name, err)
+	}
+	if err = d.Set("results", results); err != nil {
+		return diag.Errorf("error setting the results of CCE restore job: %s", err)
+	}
+
+	diags := resourceRestoreJobRead(ctx, d, meta)
+	switch phase := utils.PathSearch("status.phase", restore, "").(string); phase {
+	case "Failed", "FailedValidation":
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("CCE restore job (%s) is %s", name, phase),
+			Detail: fmt.Sprintf("%v", utils.PathSearch("status.failureReason || status.validationErrors", restore,
+				"see the results for details")),
+		})
+	case "PartiallyFailed":
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("CCE restore job (%s) is partially failed, see the results for details", name),
+		})
+	}
+	return diags
+}
+
+// getRestoreJobResults downloads the warnings and errors of each restored resource through a download request.
+func getRestoreJobResults(ctx context.Context, client *golangsdk.ServiceClient, clusterId, name string,
+	timeout time.Duration) ([]map[string]interface{}, error) {
+	requestName := fmt.Sprintf("%s-results-%d", name, time.Now().Unix())
+	_, err := doBackupResourceRequest(client, "POST", clusterId, backupDownloadRequestResource, "",
+		map[string]interface{}{
+			"apiVersion": backupApiVersion,
+			"kind":       "DownloadRequest",
+			"metadata": map[string]interface{}{
+				"name":      requestName,
+				"namespace": "velero",
+			},
+			"spec": map[string]interface{}{
+				"target": map[string]interface{}{
+					"kind": "RestoreResults",
+					"name": name,
+				},
+			},
+		})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, err := doBackupResourceRequest(client, "DELETE", clusterId, backupDownloadRequestResource, requestName, nil)
+		if err != nil {
+			log.Printf("[WARN] error deleting the download request (%s): %s", requestName, err)
+		}
+	}()
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			request, err := doBackupResourceRequest(client, "GET", clusterId, backupDownloadRequestResource,
+				requestName, nil)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if utils.PathSearch("status.phase", request, "").(string) == "Processed" {
+				return request, "COMPLETED", nil
+			}
+			return request, "PENDING", nil
+		},
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+	}
+	request, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadURL := utils.PathSearch("status.downloadURL", request, "").(string)
+	if downloadURL == "" {
+		return nil, fmt.Errorf("the download URL is not found")
+	}
+	// The HTTP client of the provider is used, so the proxy, insecure and CA settings of the provider take effect.
+	// The download URL is pre-signed, so no authentication header is needed.
+	resp, err := client.HTTPClient.Get(downloadURL) // #nosec G107
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d when downloading the results", resp.StatusCode)
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	var results map[string]interface{}
+	if err = json.NewDecoder(reader).Decode(&results); err != nil {
+		return nil, err
+	}
+	return flattenRestoreJobResults(results), nil
+}
+
+func flattenRestoreJobMessages(resultType, scope, namespace string, messages interface{}) []map[string]interface{} {
+	messageList, _ := messages.([]interface{})
+	result := make([]map[string]interface{}, 0, len(messageList))
+	for _, message := range messageList {
+		result = append(result, map[string]interface{}{
+			"type":      resultType,
+			"scope":     scope,
+			"namespace": namespace,
+			"message":   fmt.Sprint(message),
+		})
+	}
+	return result
+}
+
+func flattenRestoreJobResults(results map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, resultType := range []string{"errors", "warnings"} {
+		typeResults := utils.PathSearch(resultType, results, nil)
+		for _, scope := range []string{"velero", "cluster"} {
+			result = append(result, flattenRestoreJobMessages(resultType, scope, "",
+				utils.PathSearch(scope, typeResults, nil))...)
+		}
+
+		namespaces, _ := utils.PathSearch("namespaces", typeResults, nil).(map[string]interface{})
+		namespaceNames := make([]string, 0, len(namespaces))
+		for namespace := range namespaces {
+			namespaceNames = append(namespaceNames, namespace)
+		}
+		sort.Strings(namespaceNames)
+		for _, namespace := range namespaceNames {
+			result = append(result, flattenRestoreJobMessages(resultType, "namespace", namespace,
+				namespaces[namespace])...)
+		}
+	}
+	return result
+}
+
+func resourceRestoreJobRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	restore, err := doBackupResourceRequest(client, "GET", d.Get("cluster_id").(string), backupRestoreResource,
+		d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE restore job")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("phase", utils.PathSearch("status.phase", restore, nil)),
+		d.Set("total_items", utils.PathSearch("status.progress.totalItems", restore, nil)),
+		d.Set("items_restored", utils.PathSearch("status.progress.itemsRestored", restore, nil)),
+		d.Set("warnings", utils.PathSearch("status.warnings", restore, nil)),
+		d.Set("errors", utils.PathSearch("status.errors", restore, nil)),
+		d.Set("started_at", utils.PathSearch("status.startTimestamp", restore, nil)),
+		d.Set("completed_at", utils.PathSearch("status.completionTimestamp", restore, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE restore job fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRestoreJobDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CceV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	// Only the restore record is deleted, the restored resources are kept in the cluster.
+	_, err = doBackupResourceRequest(client, "DELETE", d.Get("cluster_id").(string), backupRestoreResource, d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE restore job")
+	}
+	return nil
+}
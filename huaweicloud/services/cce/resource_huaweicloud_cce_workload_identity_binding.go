@@ -0,0 +1,286 @@
+package cce
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/identity/v3.0/policies"
+	"github.com/chnsz/golangsdk/openstack/identity/v3/groups"
+	"github.com/chnsz/golangsdk/openstack/identity/v3/roles"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// @API IAM POST /v3/groups
+// @API IAM GET /v3/groups/{group_id}
+// @API IAM DELETE /v3/groups/{group_id}
+// @API IAM POST /v3.0/OS-ROLE/roles
+// @API IAM GET /v3.0/OS-ROLE/roles/{role_id}
+// @API IAM DELETE /v3.0/OS-ROLE/roles/{role_id}
+// @API IAM PUT /v3/domains/{domain_id}/groups/{group_id}/roles/{role_id}
+// @API IAM DELETE /v3/domains/{domain_id}/groups/{group_id}/roles/{role_id}
+func ResourceWorkloadIdentityBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkloadIdentityBindingCreate,
+		ReadContext:   resourceWorkloadIdentityBindingRead,
+		DeleteContext: resourceWorkloadIdentityBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkloadIdentityBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agency_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, workloadIdentityMaxNameLength),
+			},
+			"policy_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, workloadIdentityMaxNameLength),
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// The maximum length of the names of the IAM user group and the custom policy.
+const workloadIdentityMaxNameLength = 64
+
+// buildWorkloadIdentityDefaultName returns the default name of the user group and the policy. If the name is too long,
+// it is truncated and suffixed with the hash of the full name to keep it unique.
+func buildWorkloadIdentityDefaultName(providerId, namespace, serviceAccount string) string {
+	name := fmt.Sprintf("%s_%s_%s", providerId, namespace, serviceAccount)
+	if len(name) <= workloadIdentityMaxNameLength {
+		return name
+	}
+
+	hash := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(hash[:])[:8]
+	return fmt.Sprintf("%s_%s", name[:workloadIdentityMaxNameLength-len(suffix)-1], suffix)
+}
+
+func buildWorkloadIdentitySubject(namespace, serviceAccount string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount)
+}
+
+// buildWorkloadIdentityUsername returns the name of the federated user, which is used in the conversion rule.
+func buildWorkloadIdentityUsername(namespace, serviceAccount string) string {
+	return fmt.Sprintf("%s-%s", namespace, serviceAccount)
+}
+
+func buildWorkloadIdentityPolicyCreateOpts(d *schema.ResourceData, policyName string) policies.CreateOpts {
+	return policies.CreateOpts{
+		Name: policyName,
+		Type: "AX",
+		Description: fmt.Sprintf("Allows the service account %s to assume the agency",
+			buildWorkloadIdentitySubject(d.Get("namespace").(string), d.Get("service_account").(string))),
+		Policy: policies.Policy{
+			Version: "1.1",
+			Statement: []policies.Statement{
+				{
+					Effect: "Allow",
+					Action: []string{"iam:agencies:assume"},
+					Resource: map[string]interface{}{
+						"uri": []string{fmt.Sprintf("/iam/agencies/%s", d.Get("agency_id"))},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceWorkloadIdentityBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	identityClient, err := cfg.IAMV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+	if cfg.DomainID == "" {
+		return diag.Errorf("the domain_id must be specified in the provider configuration")
+	}
+
+	var (
+		providerId     = d.Get("provider_id").(string)
+		namespace      = d.Get("namespace").(string)
+		serviceAccount = d.Get("service_account").(string)
+		subject        = buildWorkloadIdentitySubject(namespace, serviceAccount)
+		defaultName    = buildWorkloadIdentityDefaultName(providerId, namespace, serviceAccount)
+	)
+
+	// The user group is dedicated to the service account, so the policy is not granted to any other users.
+	groupName := d.Get("group_name").(string)
+	if groupName == "" {
+		groupName = defaultName
+	}
+	groupOpts := groups.CreateOpts{
+		Name:        groupName,
+		DomainID:    cfg.DomainID,
+		Description: fmt.Sprintf("The federated users of the service account %s", subject),
+	}
+	group, err := groups.Create(identityClient, groupOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating the user group of the workload identity binding: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", providerId, namespace, serviceAccount))
+	if err = d.Set("group_id", group.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyName := d.Get("policy_name").(string)
+	if policyName == "" {
+		policyName = defaultName
+	}
+	policy, err := policies.Create(identityClient, buildWorkloadIdentityPolicyCreateOpts(d, policyName)).Extract()
+	if err != nil {
+		return diag.Errorf("error creating the agency policy of the workload identity binding: %s", err)
+	}
+	if err = d.Set("policy_id", policy.ID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignOpts := roles.AssignOpts{
+		GroupID:  group.ID,
+		DomainID: cfg.DomainID,
+	}
+	if err = roles.Assign(identityClient, policy.ID, assignOpts).ExtractErr(); err != nil {
+		return diag.Errorf("error granting the agency policy (%s) to IAM user group (%s): %s", policy.ID, group.ID, err)
+	}
+
+	return resourceWorkloadIdentityBindingRead(ctx, d, meta)
+}
+
+func resourceWorkloadIdentityBindingRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	identityClient, err := cfg.IAMV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	group, err := groups.Get(identityClient, d.Get("group_id").(string)).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the user group of the workload identity binding")
+	}
+	policy, err := policies.Get(identityClient, d.Get("policy_id").(string)).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the agency policy of the workload identity binding")
+	}
+
+	var (
+		namespace      = d.Get("namespace").(string)
+		serviceAccount = d.Get("service_account").(string)
+	)
+	mErr := multierror.Append(nil,
+		d.Set("subject", buildWorkloadIdentitySubject(namespace, serviceAccount)),
+		d.Set("username", buildWorkloadIdentityUsername(namespace, serviceAccount)),
+		d.Set("group_name", group.Name),
+		d.Set("policy_name", policy.Name),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting workload identity binding fields: %s", err)
+	}
+	return nil
+}
+
+func resourceWorkloadIdentityBindingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	identityClient, err := cfg.IAMV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	var (
+		policyId = d.Get("policy_id").(string)
+		groupId  = d.Get("group_id").(string)
+	)
+
+	unassignOpts := roles.UnassignOpts{
+		GroupID:  groupId,
+		DomainID: cfg.DomainID,
+	}
+	err = roles.Unassign(identityClient, policyId, unassignOpts).ExtractErr()
+	if err != nil && !errors.As(err, &golangsdk.ErrDefault404{}) {
+		return diag.Errorf("error revoking the agency policy (%s) from IAM user group (%s): %s", policyId, groupId, err)
+	}
+
+	err = policies.Delete(identityClient, policyId).ExtractErr()
+	if err != nil && !errors.As(err, &golangsdk.ErrDefault404{}) {
+		return diag.Errorf("error deleting the agency policy (%s) of the workload identity binding: %s", policyId, err)
+	}
+
+	err = groups.Delete(identityClient, groupId).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting the user group of the workload identity binding")
+	}
+	return nil
+}
+
+func resourceWorkloadIdentityBindingImport(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid format specified for import ID, want "+
+			"'<provider_id>/<namespace>/<service_account>/<group_id>/<policy_id>', but got '%s'", d.Id())
+	}
+
+	d.SetId(strings.Join(parts[:3], "/"))
+	mErr := multierror.Append(nil,
+		d.Set("provider_id", parts[0]),
+		d.Set("namespace", parts[1]),
+		d.Set("service_account", parts[2]),
+		d.Set("group_id", parts[3]),
+		d.Set("policy_id", parts[4]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
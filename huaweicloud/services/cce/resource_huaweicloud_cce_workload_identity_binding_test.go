@@ -0,0 +1,21 @@
+package cce
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildWorkloadIdentityDefaultName(t *testing.T) {
+	assert.Equal(t, "provider_default_obs-reader",
+		buildWorkloadIdentityDefaultName("provider", "default", "obs-reader"))
+
+	longNamespace := strings.Repeat("n", 40)
+	first := buildWorkloadIdentityDefaultName("provider", longNamespace, "obs-reader-with-a-long-name")
+	second := buildWorkloadIdentityDefaultName("provider", longNamespace, "obs-writer-with-a-long-name")
+	assert.Len(t, first, workloadIdentityMaxNameLength)
+	assert.Len(t, second, workloadIdentityMaxNameLength)
+	assert.True(t, strings.HasPrefix(first, "provider_"+longNamespace))
+	assert.NotEqual(t, first, second)
+}
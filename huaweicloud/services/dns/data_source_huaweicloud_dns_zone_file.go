@@ -0,0 +1,188 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/pagination"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// dnsZoneRecordSet is a record set of the zone file, the name is a lower case FQDN and the records are sorted.
+type dnsZoneRecordSet struct {
+	ID      string
+	Name    string
+	Type    string
+	TTL     int
+	Records []string
+	Default bool
+	Status  string
+}
+
+func (r dnsZoneRecordSet) key() string {
+	return r.Name + "|" + r.Type
+}
+
+// @API DNS GET /v2/zones/{zone_id}
+// @API DNS GET /v2.1/zones/{zone_id}/recordsets
+// @API DNS GET /v2/zones/{zone_id}/recordsets
+func DataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the zone to be exported.`,
+			},
+			"zone_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the zone.`,
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The content of the zone in BIND zone file format.`,
+			},
+		},
+	}
+}
+
+func sortDNSZoneRecordSets(recordSets []dnsZoneRecordSet) {
+	sort.Slice(recordSets, func(i, j int) bool {
+		if recordSets[i].Name != recordSets[j].Name {
+			return recordSets[i].Name < recordSets[j].Name
+		}
+		return recordSets[i].Type < recordSets[j].Type
+	})
+}
+
+// listDNSZoneRecordSets returns the record sets of the default resolution line, the record sets of other lines can not
+// be represented in the zone file.
+func listDNSZoneRecordSets(client *golangsdk.ServiceClient, zoneID, zoneType string) ([]dnsZoneRecordSet, error) {
+	version := getApiVersionByZoneType(zoneType)
+	listPath := client.Endpoint + fmt.Sprintf("%s/zones/%s/recordsets", version, zoneID)
+	listResp, err := pagination.ListAllItems(
+		client,
+		"offset",
+		listPath,
+		&pagination.QueryOpts{MarkerField: ""})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving record sets of DNS zone (%s): %s", zoneID, err)
+	}
+
+	listRespJson, err := json.Marshal(listResp)
+	if err != nil {
+		return nil, err
+	}
+	var listRespBody interface{}
+	err = json.Unmarshal(listRespJson, &listRespBody)
+	if err != nil {
+		return nil, err
+	}
+
+	rawRecordSets := utils.PathSearch("recordsets", listRespBody, make([]interface{}, 0)).([]interface{})
+	recordSets := make([]dnsZoneRecordSet, 0, len(rawRecordSets))
+	for _, v := range rawRecordSets {
+		line := utils.PathSearch("line", v, "").(string)
+		if line != "" && line != "default_view" {
+			continue
+		}
+
+		records := utils.ExpandToStringList(utils.PathSearch("records", v, make([]interface{}, 0)).([]interface{}))
+		sort.Strings(records)
+		recordSets = append(recordSets, dnsZoneRecordSet{
+			ID:      utils.PathSearch("id", v, "").(string),
+			Name:    strings.ToLower(utils.PathSearch("name", v, "").(string)),
+			Type:    utils.PathSearch("type", v, "").(string),
+			TTL:     int(utils.PathSearch("ttl", v, float64(0)).(float64)),
+			Records: records,
+			Default: utils.PathSearch("default", v, false).(bool),
+			Status:  utils.PathSearch("status", v, "").(string),
+		})
+	}
+	sortDNSZoneRecordSets(recordSets)
+	return recordSets, nil
+}
+
+func buildDNSZoneFileRelativeName(name, zoneName string) string {
+	if name == zoneName {
+		return "@"
+	}
+	if strings.HasSuffix(name, "."+zoneName) {
+		return strings.TrimSuffix(name, "."+zoneName)
+	}
+	return name
+}
+
+// buildDNSZoneFileContent exports the record sets in BIND zone file format, the SOA and NS record sets of the zone apex
+// are written first.
+func buildDNSZoneFileContent(zoneName string, recordSets []dnsZoneRecordSet) string {
+	sorted := make([]dnsZoneRecordSet, len(recordSets))
+	copy(sorted, recordSets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return getDNSZoneFileRecordSetOrder(sorted[i], zoneName) < getDNSZoneFileRecordSetOrder(sorted[j], zoneName)
+	})
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("$ORIGIN %s\n", zoneName))
+	for _, recordSet := range sorted {
+		name := buildDNSZoneFileRelativeName(recordSet.Name, zoneName)
+		for _, record := range recordSet.Records {
+			content.WriteString(fmt.Sprintf("%s\t%d\tIN\t%s\t%s\n", name, recordSet.TTL, recordSet.Type, record))
+		}
+	}
+	return content.String()
+}
+
+func getDNSZoneFileRecordSetOrder(recordSet dnsZoneRecordSet, zoneName string) int {
+	switch {
+	case recordSet.Name == zoneName && recordSet.Type == "SOA":
+		return 0
+	case recordSet.Name == zoneName && recordSet.Type == "NS":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func dataSourceZoneFileRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	zoneID := d.Get("zone_id").(string)
+
+	client, zoneInfo, err := chooseDNSClientAndZone(cfg, region, zoneID)
+	if err != nil {
+		return diag.Errorf("error retrieving DNS zone (%s): %s", zoneID, err)
+	}
+	recordSets, err := listDNSZoneRecordSets(client, zoneID, zoneInfo.ZoneType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneName := strings.ToLower(zoneInfo.Name)
+	d.SetId(zoneID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("zone_name", zoneName),
+		d.Set("content", buildDNSZoneFileContent(zoneName, recordSets)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -354,14 +354,22 @@ func parseDNSV2RecordSetID(id string) (zoneID string, recordsetID string, err er
 // In most regions, the both endpoints can work well, but it's very useful for regions like `la-north-2`
 func chooseDNSClientbyZoneID(d *schema.ResourceData, zoneID string, meta interface{}) (*golangsdk.ServiceClient, string, error) {
 	conf := meta.(*config.Config)
-	region := conf.GetRegion(d)
+	client, zoneInfo, err := chooseDNSClientAndZone(conf, conf.GetRegion(d), zoneID)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, zoneInfo.ZoneType, nil
+}
 
+// chooseDNSClientAndZone builds the client by DNS zone ID and returns the zone details, it can be used where the
+// schema.ResourceData is not available, such as CustomizeDiff.
+func chooseDNSClientAndZone(conf *config.Config, region, zoneID string) (*golangsdk.ServiceClient, *zones.Zone, error) {
 	var client *golangsdk.ServiceClient
 	var zoneInfo *zones.Zone
 	// Firstly, try to ues the DNS global endpoint
 	client, err := conf.DnsV2Client(region)
 	if err != nil {
-		return nil, "", fmt.Errorf("error creating DNS client: %s", err)
+		return nil, nil, fmt.Errorf("error creating DNS client: %s", err)
 	}
 
 	// get zone with DNS global endpoint
@@ -374,15 +382,15 @@ func chooseDNSClientbyZoneID(d *schema.ResourceData, zoneID string, meta interfa
 		client, clientErr = conf.DnsWithRegionClient(region)
 		if clientErr != nil {
 			// it looks tricky as we return the fetching error rather than clientErr
-			return nil, "", err
+			return nil, nil, err
 		}
 
 		// get zone with DNS region endpoint
 		zoneInfo, err = zones.Get(client, zoneID).Extract()
 		if err != nil {
-			return nil, "", err
+			return nil, nil, err
 		}
 	}
 
-	return client, zoneInfo.ZoneType, nil
+	return client, zoneInfo, nil
 }
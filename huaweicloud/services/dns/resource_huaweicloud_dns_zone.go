@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -29,6 +30,9 @@ import (
 // @API DNS POST /v2/zones
 // @API DNS POST /v2/{project_id}/{resourceType}/{id}/tags/action
 // @API DNS GET /v2/{project_id}/{resourceType}/{id}/tags
+// @API DNS POST /v2/zones/{zone_id}/enable-dnssec
+// @API DNS POST /v2/zones/{zone_id}/disable-dnssec
+// @API DNS GET /v2/zones/{zone_id}/dnssec
 func ResourceDNSZone() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDNSZoneCreate,
@@ -108,6 +112,68 @@ func ResourceDNSZone() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"tags": common.TagsSchema(),
+			"dnssec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLE", "DISABLE"}, false),
+			},
+			"dnssec_infos": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dnssecInfoSchema(),
+			},
+		},
+	}
+}
+
+func dnssecInfoSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"flag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"key_tag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"digest_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ksk_public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ds_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -146,6 +212,9 @@ func resourceDNSZoneCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// router is required when creating private zone
 	if zoneType == "private" {
+		if _, ok := d.GetOk("dnssec"); ok {
+			return diag.Errorf("the argument (dnssec) is only supported by DNS public zone")
+		}
 		if len(router) < 1 {
 			return diag.Errorf("the argument (router) is required when creating DNS private zone")
 		}
@@ -227,6 +296,12 @@ func resourceDNSZoneCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.Get("dnssec").(string) == "ENABLE" && zoneType == "public" {
+		if err := updateDNSSECStatus(dnsClient, n.ID, "ENABLE"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// set tags
 	tagRaw := d.Get("tags").(map[string]interface{})
 	if len(tagRaw) > 0 {
@@ -287,6 +362,18 @@ func resourceDNSZoneRead(_ context.Context, d *schema.ResourceData, meta interfa
 		d.Set("enterprise_project_id", zoneInfo.EnterpriseProjectID),
 	)
 
+	if zoneInfo.ZoneType == "public" {
+		dnssecInfo, err := getDNSSECConfig(dnsClient, d.Id())
+		if err != nil {
+			log.Printf("[WARN] error fetching DNSSEC configuration of DNS zone (%s): %s", d.Id(), err)
+		} else {
+			mErr = multierror.Append(mErr,
+				d.Set("dnssec", utils.PathSearch("status", dnssecInfo, nil)),
+				d.Set("dnssec_infos", flattenDNSSECInfos(dnssecInfo)),
+			)
+		}
+	}
+
 	// save tags
 	if resourceType, err := utils.GetDNSZoneTagType(zoneInfo.ZoneType); err == nil {
 		resourceTags, err := tags.Get(dnsClient, resourceType, d.Id()).Extract()
@@ -342,6 +429,15 @@ func resourceDNSZoneUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("dnssec") {
+		if zoneType != "public" {
+			return diag.Errorf("the argument (dnssec) is only supported by DNS public zone")
+		}
+		if err := updateDNSSECStatus(dnsClient, d.Id(), d.Get("dnssec").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// update tags
 	resourceType, err := utils.GetDNSZoneTagType(zoneType)
 	if err != nil {
@@ -391,6 +487,65 @@ func updateDNSZone(ctx context.Context, d *schema.ResourceData, client *golangsd
 	return nil
 }
 
+func updateDNSSECStatus(client *golangsdk.ServiceClient, zoneId, status string) error {
+	httpUrl := "v2/zones/{zone_id}/enable-dnssec"
+	if status == "DISABLE" {
+		httpUrl = "v2/zones/{zone_id}/disable-dnssec"
+	}
+	updatePath := client.Endpoint + httpUrl
+	updatePath = strings.ReplaceAll(updatePath, "{zone_id}", zoneId)
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+	}
+	log.Printf("[DEBUG] Updating the DNSSEC status of DNS zone (%s) to %s", zoneId, status)
+	_, err := client.Request("POST", updatePath, &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating the DNSSEC status of DNS zone (%s) to %s: %s", zoneId, status, err)
+	}
+	return nil
+}
+
+func getDNSSECConfig(client *golangsdk.ServiceClient, zoneId string) (interface{}, error) {
+	getPath := client.Endpoint + "v2/zones/{zone_id}/dnssec"
+	getPath = strings.ReplaceAll(getPath, "{zone_id}", zoneId)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+// flattenDNSSECInfos returns the DS record data of the zone, which is empty if the DNSSEC is disabled.
+func flattenDNSSECInfos(resp interface{}) []map[string]interface{} {
+	if utils.PathSearch("status", resp, "").(string) != "ENABLE" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"flag":             utils.PathSearch("flags", resp, nil),
+			"key_tag":          utils.PathSearch("key_tag", resp, nil),
+			"digest_algorithm": utils.PathSearch("digest_algorithm", resp, nil),
+			"digest_type":      utils.PathSearch("digest_type", resp, nil),
+			"digest":           utils.PathSearch("digest", resp, nil),
+			"signature":        utils.PathSearch("signature", resp, nil),
+			"signature_type":   utils.PathSearch("signature_type", resp, nil),
+			"ksk_public_key":   utils.PathSearch("ksk_public_key", resp, nil),
+			"ds_record":        utils.PathSearch("ds_record", resp, nil),
+			"created_at":       utils.PathSearch("created_at", resp, nil),
+			"updated_at":       utils.PathSearch("updated_at", resp, nil),
+		},
+	}
+}
+
 func updateDNSZoneRouters(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
 	region string) error {
 	associateList, disassociateList, err := resourceGetDNSRouters(client, d, region)
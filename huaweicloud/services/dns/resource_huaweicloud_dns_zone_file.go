@@ -0,0 +1,607 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The default TTL of the record sets, which is used if the zone file has no $TTL directive and the record has no TTL.
+const defaultDNSZoneFileTTL = 300
+
+// @API DNS GET /v2/zones/{zone_id}
+// @API DNS GET /v2.1/zones/{zone_id}/recordsets
+// @API DNS POST /v2.1/zones/{zone_id}/recordsets
+// @API DNS PUT /v2.1/zones/{zone_id}/recordsets/{recordset_id}
+// @API DNS DELETE /v2.1/zones/{zone_id}/recordsets/{recordset_id}
+// @API DNS GET /v2/zones/{zone_id}/recordsets
+// @API DNS POST /v2/zones/{zone_id}/recordsets
+// @API DNS PUT /v2/zones/{zone_id}/recordsets/{recordset_id}
+// @API DNS DELETE /v2/zones/{zone_id}/recordsets/{recordset_id}
+func ResourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneFileCreate,
+		ReadContext:   resourceZoneFileRead,
+		UpdateContext: resourceZoneFileUpdate,
+		DeleteContext: resourceZoneFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceZoneFileCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the zone whose record sets are managed.`,
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the content of the zone in BIND zone file format.`,
+			},
+			"manage_soa": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Specifies whether to update the SOA record set of the zone by the zone file.`,
+			},
+			"manage_apex_ns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Specifies whether to update the NS record set of the zone apex by the zone file.`,
+			},
+			"zone_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the zone.`,
+			},
+			"record_sets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The record sets managed by the zone file.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type dnsZoneFileEntry struct {
+	line         int
+	tokens       []string
+	inheritOwner bool
+}
+
+// tokenizeDNSZoneFileLine splits a line into tokens, the comments are dropped and the quoted strings are kept as they
+// are (including the quotes). The change of the parentheses depth is returned to join the multi-line records.
+func tokenizeDNSZoneFileLine(line string) (tokens []string, depthDelta int) {
+	var (
+		token    strings.Builder
+		inQuote  bool
+		previous rune
+	)
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for _, c := range line {
+		switch {
+		case inQuote:
+			token.WriteRune(c)
+			if c == '"' && previous != '\\' {
+				inQuote = false
+			}
+		case c == '"':
+			token.WriteRune(c)
+			inQuote = true
+		case c == ';':
+			flush()
+			return tokens, depthDelta
+		case c == '(' || c == ')':
+			flush()
+			if c == '(' {
+				depthDelta++
+			} else {
+				depthDelta--
+			}
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			token.WriteRune(c)
+		}
+		previous = c
+	}
+	flush()
+	return tokens, depthDelta
+}
+
+func splitDNSZoneFileEntries(content string) ([]dnsZoneFileEntry, error) {
+	var (
+		entries []dnsZoneFileEntry
+		current dnsZoneFileEntry
+		depth   int
+	)
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if depth == 0 {
+			current = dnsZoneFileEntry{
+				line:         i + 1,
+				inheritOwner: strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t"),
+			}
+		}
+
+		tokens, delta := tokenizeDNSZoneFileLine(line)
+		depth += delta
+		if depth < 0 {
+			return nil, fmt.Errorf("unbalanced parentheses at line %d", i+1)
+		}
+		current.tokens = append(current.tokens, tokens...)
+		if depth == 0 && len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses at line %d", current.line)
+	}
+	return entries, nil
+}
+
+// parseDNSZoneFileTTL parses the TTL in seconds or in BIND units, such as 3600, 1h or 1h30m.
+func parseDNSZoneFileTTL(value string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return seconds, seconds >= 0
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, false
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * unit
+		number = ""
+	}
+	if number != "" {
+		return 0, false
+	}
+	return total, true
+}
+
+func qualifyDNSZoneFileName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// The positions of the domain names in the record data, which need to be qualified if they are relative names.
+var dnsZoneFileDomainFields = map[string][]int{
+	"CNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+	"SOA":   {0, 1},
+}
+
+// parseDNSZoneFile parses the content in BIND zone file format and groups the records by the name and type. The
+// $ORIGIN and $TTL directives are supported, the $INCLUDE and $GENERATE directives are not supported.
+func parseDNSZoneFile(content, zoneName string) ([]dnsZoneRecordSet, error) {
+	entries, err := splitDNSZoneFileEntries(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		origin     = zoneName
+		defaultTTL = defaultDNSZoneFileTTL
+		lastOwner  string
+		recordSets = make(map[string]*dnsZoneRecordSet)
+	)
+	for _, entry := range entries {
+		tokens := entry.tokens
+		if strings.HasPrefix(tokens[0], "$") {
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("missing value of the directive %s at line %d", tokens[0], entry.line)
+			}
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				origin = qualifyDNSZoneFileName(tokens[1], origin)
+			case "$TTL":
+				ttl, ok := parseDNSZoneFileTTL(tokens[1])
+				if !ok {
+					return nil, fmt.Errorf("invalid TTL (%s) at line %d", tokens[1], entry.line)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("unsupported directive %s at line %d", tokens[0], entry.line)
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !entry.inheritOwner {
+			owner = qualifyDNSZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("missing owner name at line %d", entry.line)
+		}
+		if owner != zoneName && !strings.HasSuffix(owner, "."+zoneName) {
+			return nil, fmt.Errorf("the owner name (%s) at line %d is out of the zone (%s)", owner, entry.line, zoneName)
+		}
+		lastOwner = owner
+
+		// The TTL and class are optional and can be in any order.
+		ttl := defaultTTL
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if v, ok := parseDNSZoneFileTTL(tokens[0]); ok {
+				ttl = v
+				tokens = tokens[1:]
+			} else if utils.StrSliceContains([]string{"IN", "CH", "HS"}, strings.ToUpper(tokens[0])) {
+				tokens = tokens[1:]
+			}
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("missing record type or data at line %d", entry.line)
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		data := tokens[1:]
+		for _, index := range dnsZoneFileDomainFields[recordType] {
+			if index < len(data) {
+				data[index] = qualifyDNSZoneFileName(data[index], origin)
+			}
+		}
+
+		recordSet := dnsZoneRecordSet{Name: owner, Type: recordType, TTL: ttl}
+		if existing, ok := recordSets[recordSet.key()]; ok {
+			recordSet = *existing
+		}
+		record := strings.Join(data, " ")
+		if !utils.StrSliceContains(recordSet.Records, record) {
+			recordSet.Records = append(recordSet.Records, record)
+		}
+		recordSets[recordSet.key()] = &recordSet
+	}
+
+	result := make([]dnsZoneRecordSet, 0, len(recordSets))
+	for _, recordSet := range recordSets {
+		sort.Strings(recordSet.Records)
+		result = append(result, *recordSet)
+	}
+	sortDNSZoneRecordSets(result)
+	return result, nil
+}
+
+// filterDNSZoneFileRecordSets drops the record sets which are not managed by the zone file, including the SOA and the
+// apex NS record sets which are created by the service, unless they are requested to be managed.
+func filterDNSZoneFileRecordSets(recordSets []dnsZoneRecordSet, zoneName string, manageSoa,
+	manageApexNs bool) []dnsZoneRecordSet {
+	result := make([]dnsZoneRecordSet, 0, len(recordSets))
+	for _, recordSet := range recordSets {
+		if recordSet.Type == "SOA" && !manageSoa {
+			continue
+		}
+		if recordSet.Type == "NS" && recordSet.Name == zoneName && !manageApexNs {
+			continue
+		}
+		result = append(result, recordSet)
+	}
+	return result
+}
+
+func flattenDNSZoneFileRecordSets(recordSets []dnsZoneRecordSet) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(recordSets))
+	for i, recordSet := range recordSets {
+		result[i] = map[string]interface{}{
+			"name":    recordSet.Name,
+			"type":    recordSet.Type,
+			"ttl":     recordSet.TTL,
+			"records": recordSet.Records,
+		}
+	}
+	return result
+}
+
+func resourceZoneFileCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_id") || !d.NewValueKnown("content") {
+		return d.SetNewComputed("record_sets")
+	}
+
+	// Build the record sets from the zone file, so that the differences of each record set are shown in the plan.
+	zoneName := d.Get("zone_name").(string)
+	if zoneName == "" || d.HasChange("zone_id") {
+		cfg := meta.(*config.Config)
+		region := cfg.Region
+		if v, ok := d.GetOk("region"); ok {
+			region = v.(string)
+		}
+		_, zoneInfo, err := chooseDNSClientAndZone(cfg, region, d.Get("zone_id").(string))
+		if err != nil {
+			return fmt.Errorf("error retrieving DNS zone: %s", err)
+		}
+		zoneName = strings.ToLower(zoneInfo.Name)
+	}
+
+	recordSets, err := parseDNSZoneFile(d.Get("content").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("error parsing the zone file: %s", err)
+	}
+	recordSets = filterDNSZoneFileRecordSets(recordSets, zoneName, d.Get("manage_soa").(bool),
+		d.Get("manage_apex_ns").(bool))
+	return d.SetNew("record_sets", flattenDNSZoneFileRecordSets(recordSets))
+}
+
+func doDNSZoneRecordSetRequest(client *golangsdk.ServiceClient, method, zoneID, zoneType, recordSetID string,
+	body map[string]interface{}) error {
+	version := getApiVersionByZoneType(zoneType)
+	requestPath := client.Endpoint + fmt.Sprintf("%s/zones/%s/recordsets", version, zoneID)
+	if recordSetID != "" {
+		requestPath += "/" + recordSetID
+	}
+	requestOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			202,
+		},
+	}
+	if body != nil {
+		requestOpt.JSONBody = body
+	}
+	_, err := client.Request(method, requestPath, &requestOpt)
+	return err
+}
+
+// syncDNSZoneFileRecordSets reconciles the remote record sets to the desired record sets one by one, the record sets
+// created by the service (such as SOA) are never deleted.
+func syncDNSZoneFileRecordSets(client *golangsdk.ServiceClient, zoneID, zoneType string, remote,
+	desired []dnsZoneRecordSet) error {
+	remoteMap := make(map[string]dnsZoneRecordSet, len(remote))
+	for _, recordSet := range remote {
+		remoteMap[recordSet.key()] = recordSet
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+
+	for _, recordSet := range desired {
+		desiredKeys[recordSet.key()] = true
+		existing, ok := remoteMap[recordSet.key()]
+		if !ok {
+			log.Printf("[DEBUG] Creating the %s record set (%s) of DNS zone (%s)", recordSet.Type, recordSet.Name, zoneID)
+			err := doDNSZoneRecordSetRequest(client, "POST", zoneID, zoneType, "", map[string]interface{}{
+				"name":    recordSet.Name,
+				"type":    recordSet.Type,
+				"ttl":     recordSet.TTL,
+				"records": recordSet.Records,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating %s record set (%s): %s", recordSet.Type, recordSet.Name, err)
+			}
+			continue
+		}
+
+		if existing.TTL == recordSet.TTL && strings.Join(existing.Records, "\n") == strings.Join(recordSet.Records, "\n") {
+			continue
+		}
+		log.Printf("[DEBUG] Updating the %s record set (%s) of DNS zone (%s)", recordSet.Type, recordSet.Name, zoneID)
+		err := doDNSZoneRecordSetRequest(client, "PUT", zoneID, zoneType, existing.ID, map[string]interface{}{
+			"ttl":     recordSet.TTL,
+			"records": recordSet.Records,
+		})
+		if err != nil {
+			return fmt.Errorf("error updating %s record set (%s): %s", recordSet.Type, recordSet.Name, err)
+		}
+	}
+
+	for _, recordSet := range remote {
+		if desiredKeys[recordSet.key()] || recordSet.Default {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting the %s record set (%s) of DNS zone (%s)", recordSet.Type, recordSet.Name, zoneID)
+		err := doDNSZoneRecordSetRequest(client, "DELETE", zoneID, zoneType, recordSet.ID, nil)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting %s record set (%s): %s", recordSet.Type, recordSet.Name, err)
+		}
+	}
+	return nil
+}
+
+func waitForDNSZoneFileRecordSetsActive(ctx context.Context, client *golangsdk.ServiceClient, zoneID,
+	zoneType string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			recordSets, err := listDNSZoneRecordSets(client, zoneID, zoneType)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			for _, recordSet := range recordSets {
+				switch parseStatus(recordSet.Status) {
+				case "PENDING":
+					return recordSets, "PENDING", nil
+				case "ERROR":
+					return recordSets, "ERROR", fmt.Errorf("the %s record set (%s) is in ERROR status",
+						recordSet.Type, recordSet.Name)
+				}
+			}
+			return recordSets, "COMPLETED", nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the record sets of DNS zone (%s) to be ACTIVE: %s", zoneID, err)
+	}
+	return nil
+}
+
+func applyDNSZoneFile(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	cfg := meta.(*config.Config)
+	zoneID := d.Get("zone_id").(string)
+	client, zoneInfo, err := chooseDNSClientAndZone(cfg, cfg.GetRegion(d), zoneID)
+	if err != nil {
+		return fmt.Errorf("error retrieving DNS zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := strings.ToLower(zoneInfo.Name)
+	manageSoa, manageApexNs := d.Get("manage_soa").(bool), d.Get("manage_apex_ns").(bool)
+	desired, err := parseDNSZoneFile(d.Get("content").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("error parsing the zone file: %s", err)
+	}
+	remote, err := listDNSZoneRecordSets(client, zoneID, zoneInfo.ZoneType)
+	if err != nil {
+		return err
+	}
+
+	err = syncDNSZoneFileRecordSets(client, zoneID, zoneInfo.ZoneType,
+		filterDNSZoneFileRecordSets(remote, zoneName, manageSoa, manageApexNs),
+		filterDNSZoneFileRecordSets(desired, zoneName, manageSoa, manageApexNs))
+	if err != nil {
+		return err
+	}
+	return waitForDNSZoneFileRecordSetsActive(ctx, client, zoneID, zoneInfo.ZoneType, timeout)
+}
+
+func resourceZoneFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := applyDNSZoneFile(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceZoneFileRead(ctx, d, meta)
+}
+
+func resourceZoneFileRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, zoneInfo, err := chooseDNSClientAndZone(cfg, region, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS zone file")
+	}
+
+	remote, err := listDNSZoneRecordSets(client, d.Id(), zoneInfo.ZoneType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneName := strings.ToLower(zoneInfo.Name)
+	managed := filterDNSZoneFileRecordSets(remote, zoneName, d.Get("manage_soa").(bool),
+		d.Get("manage_apex_ns").(bool))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("zone_id", d.Id()),
+		d.Set("zone_name", zoneName),
+		d.Set("record_sets", flattenDNSZoneFileRecordSets(managed)),
+	)
+	// The content is exported from the remote record sets when the resource is imported.
+	if d.Get("content").(string) == "" {
+		mErr = multierror.Append(mErr, d.Set("content", buildDNSZoneFileContent(zoneName, managed)))
+	}
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceZoneFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := applyDNSZoneFile(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceZoneFileRead(ctx, d, meta)
+}
+
+func resourceZoneFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, zoneInfo, err := chooseDNSClientAndZone(cfg, cfg.GetRegion(d), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "DNS zone file")
+	}
+
+	remote, err := listDNSZoneRecordSets(client, d.Id(), zoneInfo.ZoneType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zoneName := strings.ToLower(zoneInfo.Name)
+	managed := filterDNSZoneFileRecordSets(remote, zoneName, d.Get("manage_soa").(bool),
+		d.Get("manage_apex_ns").(bool))
+	if err := syncDNSZoneFileRecordSets(client, d.Id(), zoneInfo.ZoneType, managed, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := waitForDNSZoneFileRecordSetsActive(ctx, client, d.Id(), zoneInfo.ZoneType,
+		d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
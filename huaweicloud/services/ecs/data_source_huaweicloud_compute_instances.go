@@ -2,17 +2,38 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
 
 	"github.com/chnsz/golangsdk/openstack/ecs/v1/cloudservers"
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
+// computeInstancesPageLimit is the number of instances queried per page.
+const computeInstancesPageLimit = 100
+
+type computeInstancesPage struct {
+	Count   int                        `json:"count"`
+	Servers []cloudservers.CloudServer `json:"servers"`
+}
+
 // @API ECS GET /v1/{project_id}/cloudservers/detail
 // @API ECS GET /v1/{project_id}/cloudservers/{serverId}/block_device
 // @API EVS GET /v2/{project_id}/cloudvolumes/{id}
@@ -62,6 +83,48 @@ func DataSourceComputeInstances() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fixed_ip_v4": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_cidrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: utils.ValidateCIDR,
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"not_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"launched_since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"launched_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"compact": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -124,6 +187,15 @@ func DataSourceComputeInstances() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"private_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"launched_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"network":         computedSchemaNetworks(),
 						"volume_attached": computedSchemaVolumeAttached(),
 						"scheduler_hints": computedSchemaSchedulerHints(),
@@ -139,16 +211,225 @@ func DataSourceComputeInstances() *schema.Resource {
 	}
 }
 
-func buildListOptsWithoutIP(d *schema.ResourceData, conf *config.Config) *cloudservers.ListOpts {
-	result := cloudservers.ListOpts{
-		Limit:               100,
-		EnterpriseProjectID: conf.DataGetEnterpriseProjectID(d),
-		Name:                d.Get("name").(string),
-		Flavor:              d.Get("flavor_id").(string),
-		Status:              d.Get("status").(string),
+// buildComputeInstancesQueryParams builds the query parameters which are filtered on the server side.
+func buildComputeInstancesQueryParams(d *schema.ResourceData, conf *config.Config) url.Values {
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(computeInstancesPageLimit))
+
+	if epsId := conf.DataGetEnterpriseProjectID(d); epsId != "" {
+		params.Set("enterprise_project_id", epsId)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		params.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("flavor_id"); ok {
+		params.Set("flavor", v.(string))
+	}
+	if v, ok := d.GetOk("status"); ok {
+		params.Set("status", v.(string))
+	}
+	if v, ok := d.GetOk("fixed_ip_v4"); ok {
+		params.Set("ip", v.(string))
+	} else if ipPrefix := buildComputeInstancesIpPrefix(d.Get("ip_cidrs").([]interface{})); ipPrefix != "" {
+		params.Set("ip", ipPrefix)
+	}
+	if v, ok := d.GetOk("instance_id"); ok {
+		params.Set("server_id", v.(string))
+	}
+	if v, ok := d.GetOk("availability_zone"); ok {
+		params.Set("availability_zone", v.(string))
+	}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		tagList := make([]string, 0, len(tags.(map[string]interface{})))
+		for k, v := range tags.(map[string]interface{}) {
+			tagList = append(tagList, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(tagList)
+		params.Set("tags", strings.Join(tagList, ","))
+	}
+	// The 'not-tags' parameter matches the whole tag (key=value) of the instance, so the tag keys in 'not_tags' can
+	// not be filtered on the server side and they are filtered by isServerTagsMatched.
+
+	return params
+}
+
+// buildComputeInstancesIpPrefix returns the fixed octets of the CIDR block, which is used as the fuzzy matched 'ip'
+// parameter to narrow the listing on the server side, e.g. 192.168. for 192.168.0.0/16.
+// The exact CIDR matching is still performed by isServerIpInCidrs.
+func buildComputeInstancesIpPrefix(cidrs []interface{}) string {
+	if len(cidrs) != 1 {
+		return ""
+	}
+	_, ipNet, err := net.ParseCIDR(cidrs[0].(string))
+	if err != nil || ipNet.IP.To4() == nil {
+		return ""
+	}
+
+	ones, _ := ipNet.Mask.Size()
+	octets := strings.Split(ipNet.IP.To4().String(), ".")
+	switch fixed := ones / 8; fixed {
+	case 0:
+		return ""
+	case 4:
+		return strings.Join(octets, ".")
+	default:
+		return strings.Join(octets[:fixed], ".") + "."
+	}
+}
+
+func queryComputeInstancesPage(client *golangsdk.ServiceClient, params url.Values,
+	pageNum int) (*computeInstancesPage, error) {
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("offset", strconv.Itoa(pageNum))
+
+	listPath := client.ServiceURL("cloudservers", "detail") + "?" + query.Encode()
+	var page computeInstancesPage
+	_, err := client.Get(listPath, &page, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// queryComputeInstancesConcurrently queries the first page to obtain the total count of the instances, and then
+// queries the remaining pages concurrently, the number of parallel requests is limited by the concurrency.
+func queryComputeInstancesConcurrently(client *golangsdk.ServiceClient, params url.Values,
+	concurrency int) ([]cloudservers.CloudServer, error) {
+	firstPage, err := queryComputeInstancesPage(client, params, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := (firstPage.Count + computeInstancesPageLimit - 1) / computeInstancesPageLimit
+	if totalPages <= 1 {
+		return firstPage.Servers, nil
+	}
+
+	var (
+		pages = make([][]cloudservers.CloudServer, totalPages)
+		mErr  *multierror.Error
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		sem   = make(chan struct{}, concurrency)
+	)
+	pages[0] = firstPage.Servers
+	for pageNum := 2; pageNum <= totalPages; pageNum++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(pageNum int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			page, err := queryComputeInstancesPage(client, params, pageNum)
+			if err != nil {
+				mu.Lock()
+				mErr = multierror.Append(mErr, fmt.Errorf("error querying page %d: %s", pageNum, err))
+				mu.Unlock()
+				return
+			}
+			pages[pageNum-1] = page.Servers
+		}(pageNum)
+	}
+	wg.Wait()
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	result := make([]cloudservers.CloudServer, 0, firstPage.Count)
+	for _, servers := range pages {
+		result = append(result, servers...)
+	}
+	return result, nil
+}
+
+func isServerTagsMatched(d *schema.ResourceData, server cloudservers.CloudServer) bool {
+	serverTags := flattenEcsInstanceTags(server.Tags)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		if tagValue, ok := serverTags[k]; !ok || tagValue != v {
+			return false
+		}
 	}
+	for _, k := range d.Get("not_tags").([]interface{}) {
+		if _, ok := serverTags[k.(string)]; ok {
+			return false
+		}
+	}
+	return true
+}
 
-	return &result
+func isServerIpInCidrs(cidrs []interface{}, server cloudservers.CloudServer) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+
+	for _, addresses := range server.Addresses {
+		for _, addr := range addresses {
+			ip := net.ParseIP(addr.Addr)
+			if ip == nil {
+				continue
+			}
+			for _, cidr := range cidrs {
+				_, ipNet, err := net.ParseCIDR(cidr.(string))
+				if err == nil && ipNet.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isServerFixedIpMatched checks whether the instance has the fixed IP address, the 'ip' parameter of the list API is
+// fuzzy matched, e.g. 192.168.0.1 also matches 192.168.0.10, so the address is checked exactly on the client side.
+func isServerFixedIpMatched(fixedIp string, server cloudservers.CloudServer) bool {
+	if fixedIp == "" {
+		return true
+	}
+
+	for _, addresses := range server.Addresses {
+		for _, addr := range addresses {
+			if addr.Type == "fixed" && addr.Addr == fixedIp {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isServerLaunchTimeMatched(d *schema.ResourceData, server cloudservers.CloudServer) bool {
+	since, sinceOk := d.GetOk("launched_since")
+	before, beforeOk := d.GetOk("launched_before")
+	if !sinceOk && !beforeOk {
+		return true
+	}
+
+	// The launch time is returned in UTC without time zone, e.g. 2023-08-15T14:21:22.000000.
+	launchedAt, err := time.Parse("2006-01-02T15:04:05.000000", server.LaunchedAt)
+	if err != nil {
+		log.Printf("[WARN] unable to parse the launch time (%s) of the instance (%s): %s",
+			server.LaunchedAt, server.ID, err)
+		return false
+	}
+	if sinceOk {
+		sinceTime, _ := time.Parse(time.RFC3339, since.(string))
+		if launchedAt.Before(sinceTime) {
+			return false
+		}
+	}
+	if beforeOk {
+		beforeTime, _ := time.Parse(time.RFC3339, before.(string))
+		if !launchedAt.Before(beforeTime) {
+			return false
+		}
+	}
+	return true
 }
 
 func filterCloudServers(d *schema.ResourceData, servers []cloudservers.CloudServer) ([]cloudservers.CloudServer,
@@ -163,6 +444,7 @@ func filterCloudServers(d *schema.ResourceData, servers []cloudservers.CloudServ
 		if flavorName, ok := d.GetOk("flavor_name"); ok && flavorName != server.Flavor.Name {
 			continue
 		}
+		// The ECS list API does not support filtering by image, so the image is filtered on the client side.
 		if iamgeId, ok := d.GetOk("image_id"); ok && iamgeId != server.Image.ID {
 			continue
 		}
@@ -172,6 +454,11 @@ func filterCloudServers(d *schema.ResourceData, servers []cloudservers.CloudServ
 		if keypair, ok := d.GetOk("key_pair"); ok && keypair != server.KeyName {
 			continue
 		}
+		if !isServerTagsMatched(d, server) || !isServerLaunchTimeMatched(d, server) ||
+			!isServerFixedIpMatched(d.Get("fixed_ip_v4").(string), server) ||
+			!isServerIpInCidrs(d.Get("ip_cidrs").([]interface{}), server) {
+			continue
+		}
 		result = append(result, server)
 		ids = append(ids, server.ID)
 	}
@@ -187,8 +474,8 @@ func dataSourceComputeInstancesRead(_ context.Context, d *schema.ResourceData, m
 		return diag.Errorf("error creating ECS client: %s", err)
 	}
 
-	opts := buildListOptsWithoutIP(d, conf)
-	allServers, err := queryEcsInstances(ecsClient, opts)
+	params := buildComputeInstancesQueryParams(d, conf)
+	allServers, err := queryComputeInstancesConcurrently(ecsClient, params, d.Get("max_concurrency").(int))
 	if err != nil {
 		return diag.Errorf("unable to retrieve ECS instances: %s", err)
 	}
@@ -197,9 +484,55 @@ func dataSourceComputeInstancesRead(_ context.Context, d *schema.ResourceData, m
 	// Save the data source ID using a hash code constructed using all instance IDs.
 	d.SetId(hashcode.Strings(ids))
 
+	if d.Get("compact").(bool) {
+		return setComputeInstancesCompactParams(d, servers)
+	}
 	return setComputeInstancesParams(d, conf, servers)
 }
 
+func flattenEcsInstancePrivateIps(addressResp map[string][]cloudservers.Address) []string {
+	result := make([]string, 0)
+	for _, addresses := range addressResp {
+		for _, addr := range addresses {
+			if addr.Type != "floating" {
+				result = append(result, addr.Addr)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func flattenEcsInstancePublicIp(addressResp map[string][]cloudservers.Address) string {
+	for _, addresses := range addressResp {
+		for _, addr := range addresses {
+			if addr.Type == "floating" {
+				return addr.Addr
+			}
+		}
+	}
+	return ""
+}
+
+// setComputeInstancesCompactParams only saves the IDs, names and IP addresses of the instances, and no other APIs
+// will be called to query the networks and volumes of each instance.
+func setComputeInstancesCompactParams(d *schema.ResourceData, servers []cloudservers.CloudServer) diag.Diagnostics {
+	result := make([]map[string]interface{}, len(servers))
+	for i, item := range servers {
+		result[i] = map[string]interface{}{
+			"id":          item.ID,
+			"name":        item.Name,
+			"private_ips": flattenEcsInstancePrivateIps(item.Addresses),
+			"public_ip":   flattenEcsInstancePublicIp(item.Addresses),
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instances", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
 func setComputeInstancesParams(d *schema.ResourceData, conf *config.Config, servers []cloudservers.CloudServer) diag.Diagnostics {
 	region := conf.GetRegion(d)
 	ecsClient, err := conf.ComputeV1Client(region)
@@ -231,6 +564,8 @@ func setComputeInstancesParams(d *schema.ResourceData, conf *config.Config, serv
 			"enterprise_project_id": item.EnterpriseProjectID,
 			"user_data":             item.UserData,
 			"key_pair":              item.KeyName,
+			"launched_at":           item.LaunchedAt,
+			"private_ips":           flattenEcsInstancePrivateIps(item.Addresses),
 			"tags":                  flattenEcsInstanceTags(item.Tags),
 			"security_group_ids":    flattenEcsInstanceSecurityGroupIds(item.SecurityGroups),
 			"scheduler_hints":       flattenEcsInstanceSchedulerHints(item.OsSchedulerHints),
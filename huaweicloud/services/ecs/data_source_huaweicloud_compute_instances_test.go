@@ -0,0 +1,50 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chnsz/golangsdk/openstack/ecs/v1/cloudservers"
+)
+
+func testComputeInstanceWithFixedIp(id, fixedIp string) cloudservers.CloudServer {
+	return cloudservers.CloudServer{
+		ID: id,
+		Addresses: map[string][]cloudservers.Address{
+			"vpc-id": {
+				{Version: "4", Addr: fixedIp, Type: "fixed"},
+			},
+		},
+	}
+}
+
+func TestFilterCloudServersByFixedIp(t *testing.T) {
+	d := DataSourceComputeInstances().TestResourceData()
+	assert.NoError(t, d.Set("fixed_ip_v4", "192.168.0.1"))
+
+	// The 'ip' parameter of the list API is fuzzy matched, so the instances with the overlapping addresses are
+	// also returned.
+	servers := []cloudservers.CloudServer{
+		testComputeInstanceWithFixedIp("instance-1", "192.168.0.1"),
+		testComputeInstanceWithFixedIp("instance-10", "192.168.0.10"),
+		testComputeInstanceWithFixedIp("instance-19", "192.168.0.19"),
+		testComputeInstanceWithFixedIp("instance-100", "192.168.0.100"),
+		testComputeInstanceWithFixedIp("instance-other", "10.192.168.0.1"),
+	}
+
+	result, ids := filterCloudServers(d, servers)
+	assert.Len(t, result, 1)
+	assert.Equal(t, []string{"instance-1"}, ids)
+}
+
+func TestIsServerFixedIpMatched(t *testing.T) {
+	server := testComputeInstanceWithFixedIp("instance-1", "192.168.0.1")
+	server.Addresses["vpc-id"] = append(server.Addresses["vpc-id"],
+		cloudservers.Address{Version: "4", Addr: "192.168.0.2", Type: "floating"})
+
+	assert.True(t, isServerFixedIpMatched("", server))
+	assert.True(t, isServerFixedIpMatched("192.168.0.1", server))
+	assert.False(t, isServerFixedIpMatched("192.168.0.10", server))
+	assert.False(t, isServerFixedIpMatched("192.168.0.2", server))
+}
@@ -0,0 +1,811 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The forwarding policy requires the advanced forwarding of the listener to be enabled, it manages the policy and its
+// rules (conditions) together.
+
+// @API ELB POST /v3/{project_id}/elb/l7policies
+// @API ELB GET /v3/{project_id}/elb/l7policies
+// @API ELB GET /v3/{project_id}/elb/l7policies/{l7policy_id}
+// @API ELB PUT /v3/{project_id}/elb/l7policies/{l7policy_id}
+// @API ELB DELETE /v3/{project_id}/elb/l7policies/{l7policy_id}
+// @API ELB GET /v3/{project_id}/elb/l7policies/{l7policy_id}/rules
+func ResourceForwardingPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceForwardingPolicyCreate,
+		ReadContext:   resourceForwardingPolicyRead,
+		UpdateContext: resourceForwardingPolicyUpdate,
+		DeleteContext: resourceForwardingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceForwardingPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"listener_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the listener to which the forwarding policy belongs.`,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 10000),
+				Description:  `The priority of the forwarding policy, a smaller value indicates a higher priority.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The name of the forwarding policy.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description of the forwarding policy.`,
+			},
+			"conditions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"HOST_NAME", "PATH", "METHOD", "HEADER", "QUERY_STRING", "SOURCE_IP",
+							}, false),
+							Description: `The type of the condition.`,
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The values to be matched, the condition is matched if any value is matched.`,
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the header or the query parameter to be matched.`,
+						},
+						"compare_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "EQUAL_TO",
+							ValidateFunc: validation.StringInSlice([]string{
+								"EQUAL_TO", "REGEX", "STARTS_WITH",
+							}, false),
+							Description: `The match method of the condition.`,
+						},
+						"invert": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Whether to invert the matching result of the condition.`,
+						},
+					},
+				},
+				Description: `The conditions of the forwarding policy, the policy is matched if all conditions are matched.`,
+			},
+			"forward_to_pools": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"forward_to_pools", "redirect_to_listener_id", "redirect_to_url", "fixed_response",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pools": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pool_id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The ID of the backend pool.`,
+									},
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(0, 100),
+										Description:  `The weight of the backend pool.`,
+									},
+								},
+							},
+							Description: `The backend pools to which the requests are forwarded.`,
+						},
+						"sticky_session_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Whether to enable the sticky session among the backend pools.`,
+						},
+						"sticky_session_timeout": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `The timeout of the sticky session, in minutes.`,
+						},
+						"rewrite_url": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: `The host name of the rewritten URL.`,
+									},
+									"path": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: `The path of the rewritten URL.`,
+									},
+									"query": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The query string of the rewritten URL.`,
+									},
+								},
+							},
+							Description: `The configuration of rewriting the request URL.`,
+						},
+						"insert_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The name of the header.`,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The value of the header.`,
+									},
+									"value_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "USER_DEFINED",
+										ValidateFunc: validation.StringInSlice([]string{
+											"USER_DEFINED", "REFERENCE_HEADER", "SYSTEM_DEFINED",
+										}, false),
+										Description: `The type of the header value.`,
+									},
+								},
+							},
+							Description: `The headers inserted into the requests.`,
+						},
+						"remove_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The names of the headers removed from the requests.`,
+						},
+					},
+				},
+				Description: `The action of forwarding the requests to the backend pools.`,
+			},
+			"redirect_to_listener_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The ID of the listener to which the requests are redirected.`,
+			},
+			"redirect_to_url": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     redirectUrlConfigSchema(),
+			},
+			"fixed_response": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     fixedResponseConfigSchema(),
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The action type of the forwarding policy.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The provisioning status of the forwarding policy.`,
+			},
+		},
+	}
+}
+
+func listListenerPolicies(client *golangsdk.ServiceClient, listenerId string) ([]interface{}, error) {
+	listPath := client.Endpoint + "v3/{project_id}/elb/l7policies?listener_id={listener_id}&limit=2000"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{listener_id}", listenerId)
+
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	var (
+		result = make([]interface{}, 0)
+		marker string
+	)
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath += "&marker=" + marker
+		}
+		listResp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		listRespBody, err := utils.FlattenResponse(listResp)
+		if err != nil {
+			return nil, err
+		}
+
+		policies := utils.PathSearch("l7policies", listRespBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, policies...)
+		marker = utils.PathSearch("page_info.next_marker", listRespBody, "").(string)
+		if marker == "" || len(policies) < 1 {
+			break
+		}
+	}
+	return result, nil
+}
+
+// forwardingPolicyActionKeys maps the action types to the arguments which configure them.
+var forwardingPolicyActionKeys = map[string]string{
+	"REDIRECT_TO_POOL":     "forward_to_pools",
+	"REDIRECT_TO_LISTENER": "redirect_to_listener_id",
+	"REDIRECT_TO_URL":      "redirect_to_url",
+	"FIXED_RESPONSE":       "fixed_response",
+}
+
+// resourceForwardingPolicyCustomizeDiff replaces the policy when its action type is changed, and checks whether the
+// priority is used by another policy of the listener.
+func resourceForwardingPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		oldAction := d.Get("action").(string)
+		if oldKey, ok := forwardingPolicyActionKeys[oldAction]; ok && d.HasChange(oldKey) {
+			if _, ok := d.GetOk(oldKey); !ok {
+				// The action type can not be updated.
+				if err := d.ForceNew(oldKey); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if !d.HasChange("priority") || !d.NewValueKnown("listener_id") || !d.NewValueKnown("priority") {
+		return nil
+	}
+	listenerId := d.Get("listener_id").(string)
+	if listenerId == "" {
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := cfg.NewServiceClient("elb", region)
+	if err != nil {
+		return fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	err = checkForwardingPolicyPriority(client, listenerId, d.Id(), "", d.Get("priority").(int))
+	if _, ok := err.(golangsdk.ErrDefault404); ok {
+		return nil
+	}
+	return err
+}
+
+// checkForwardingPolicyPriority checks whether the priority is used by another policy of the listener.
+// If createdAt is specified, the policies created after it are ignored, so only the later one of two policies which
+// use the same priority reports the conflict.
+func checkForwardingPolicyPriority(client *golangsdk.ServiceClient, listenerId, policyId, createdAt string,
+	priority int) error {
+	policies, err := listListenerPolicies(client, listenerId)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return err
+		}
+		return fmt.Errorf("error retrieving forwarding policies of listener (%s): %s", listenerId, err)
+	}
+
+	for _, policy := range policies {
+		otherId := utils.PathSearch("id", policy, "").(string)
+		if otherId == policyId {
+			continue
+		}
+		if otherCreatedAt := utils.PathSearch("created_at", policy, "").(string); createdAt != "" &&
+			(otherCreatedAt > createdAt || otherCreatedAt == createdAt && otherId > policyId) {
+			continue
+		}
+		if int(utils.PathSearch("priority", policy, float64(-1)).(float64)) == priority {
+			return fmt.Errorf("the priority (%d) has been used by the forwarding policy (%s) of listener (%s)",
+				priority, otherId, listenerId)
+		}
+	}
+	return nil
+}
+
+func buildForwardingPolicyRules(d *schema.ResourceData) []map[string]interface{} {
+	rawConditions := d.Get("conditions").([]interface{})
+	result := make([]map[string]interface{}, 0, len(rawConditions))
+	for _, v := range rawConditions {
+		raw := v.(map[string]interface{})
+		values := utils.ExpandToStringList(raw["values"].([]interface{}))
+		conditions := make([]map[string]interface{}, len(values))
+		for i, value := range values {
+			conditions[i] = utils.RemoveNil(map[string]interface{}{
+				"key":   utils.ValueIngoreEmpty(raw["key"]),
+				"value": value,
+			})
+		}
+
+		rule := map[string]interface{}{
+			"type":         raw["type"],
+			"compare_type": raw["compare_type"],
+			"invert":       raw["invert"],
+			"conditions":   conditions,
+		}
+		if len(values) > 0 {
+			rule["value"] = values[0]
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func buildForwardingPolicyPoolsExtendConfig(raw map[string]interface{}) map[string]interface{} {
+	params := make(map[string]interface{})
+	if rawArray := raw["rewrite_url"].([]interface{}); len(rawArray) > 0 && rawArray[0] != nil {
+		rewrite := rawArray[0].(map[string]interface{})
+		params["rewrite_url_enable"] = true
+		params["rewrite_url_config"] = map[string]interface{}{
+			"host":  utils.ValueIngoreEmpty(rewrite["host"]),
+			"path":  utils.ValueIngoreEmpty(rewrite["path"]),
+			"query": utils.ValueIngoreEmpty(rewrite["query"]),
+		}
+	} else {
+		params["rewrite_url_enable"] = false
+	}
+
+	insertHeaders := raw["insert_headers"].([]interface{})
+	insertConfigs := make([]map[string]interface{}, 0, len(insertHeaders))
+	for _, v := range insertHeaders {
+		header := v.(map[string]interface{})
+		insertConfigs = append(insertConfigs, map[string]interface{}{
+			"key":        header["key"],
+			"value":      header["value"],
+			"value_type": header["value_type"],
+		})
+	}
+	params["insert_headers_config"] = map[string]interface{}{
+		"configs": insertConfigs,
+	}
+
+	removeHeaders := utils.ExpandToStringList(raw["remove_headers"].([]interface{}))
+	removeConfigs := make([]map[string]interface{}, 0, len(removeHeaders))
+	for _, key := range removeHeaders {
+		removeConfigs = append(removeConfigs, map[string]interface{}{
+			"key": key,
+		})
+	}
+	params["remove_headers_config"] = map[string]interface{}{
+		"configs": removeConfigs,
+	}
+	return params
+}
+
+func buildForwardingPolicyRedirectUrlConfig(d *schema.ResourceData) map[string]interface{} {
+	rawArray := d.Get("redirect_to_url").([]interface{})
+	if len(rawArray) < 1 || rawArray[0] == nil {
+		return nil
+	}
+	raw := rawArray[0].(map[string]interface{})
+	return map[string]interface{}{
+		"status_code": raw["status_code"],
+		"protocol":    utils.ValueIngoreEmpty(raw["protocol"]),
+		"host":        utils.ValueIngoreEmpty(raw["host"]),
+		"port":        utils.ValueIngoreEmpty(raw["port"]),
+		"path":        utils.ValueIngoreEmpty(raw["path"]),
+		"query":       utils.ValueIngoreEmpty(raw["query"]),
+	}
+}
+
+func buildForwardingPolicyFixedResponseConfig(d *schema.ResourceData) map[string]interface{} {
+	rawArray := d.Get("fixed_response").([]interface{})
+	if len(rawArray) < 1 || rawArray[0] == nil {
+		return nil
+	}
+	raw := rawArray[0].(map[string]interface{})
+	return map[string]interface{}{
+		"status_code":  raw["status_code"],
+		"content_type": utils.ValueIngoreEmpty(raw["content_type"]),
+		"message_body": utils.ValueIngoreEmpty(raw["message_body"]),
+	}
+}
+
+// buildForwardingPolicyActionParams returns the action type and the action parameters of the forwarding policy.
+func buildForwardingPolicyActionParams(d *schema.ResourceData) map[string]interface{} {
+	if rawArray := d.Get("forward_to_pools").([]interface{}); len(rawArray) > 0 && rawArray[0] != nil {
+		raw := rawArray[0].(map[string]interface{})
+		rawPools := raw["pools"].([]interface{})
+		pools := make([]map[string]interface{}, 0, len(rawPools))
+		for _, v := range rawPools {
+			pool := v.(map[string]interface{})
+			pools = append(pools, map[string]interface{}{
+				"pool_id": pool["pool_id"],
+				"weight":  pool["weight"],
+			})
+		}
+
+		return map[string]interface{}{
+			"action":                "REDIRECT_TO_POOL",
+			"redirect_pools_config": pools,
+			"redirect_pools_sticky_session_config": utils.RemoveNil(map[string]interface{}{
+				"enable":  raw["sticky_session_enabled"],
+				"timeout": utils.ValueIngoreEmpty(raw["sticky_session_timeout"]),
+			}),
+			"redirect_pools_extend_config": buildForwardingPolicyPoolsExtendConfig(raw),
+		}
+	}
+	if v, ok := d.GetOk("redirect_to_listener_id"); ok {
+		return map[string]interface{}{
+			"action":               "REDIRECT_TO_LISTENER",
+			"redirect_listener_id": v,
+		}
+	}
+	if urlConfig := buildForwardingPolicyRedirectUrlConfig(d); urlConfig != nil {
+		return map[string]interface{}{
+			"action":              "REDIRECT_TO_URL",
+			"redirect_url_config": utils.RemoveNil(urlConfig),
+		}
+	}
+	return map[string]interface{}{
+		"action":                "FIXED_RESPONSE",
+		"fixed_response_config": utils.RemoveNil(buildForwardingPolicyFixedResponseConfig(d)),
+	}
+}
+
+func buildCreateForwardingPolicyBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := buildForwardingPolicyActionParams(d)
+	params["listener_id"] = d.Get("listener_id")
+	params["priority"] = d.Get("priority")
+	params["name"] = utils.ValueIngoreEmpty(d.Get("name"))
+	params["description"] = utils.ValueIngoreEmpty(d.Get("description"))
+	params["rules"] = buildForwardingPolicyRules(d)
+
+	return map[string]interface{}{
+		"l7policy": utils.RemoveNil(params),
+	}
+}
+
+func resourceForwardingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/elb/l7policies"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildCreateForwardingPolicyBodyParams(d),
+		OkCodes: []int{
+			201,
+		},
+	}
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating forwarding policy: %s", err)
+	}
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("l7policy.id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the forwarding policy ID from the API response")
+	}
+	d.SetId(id)
+
+	err = waitForElbV3Policy(ctx, client, id, "ACTIVE", nil, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The policies created in the same apply are not checked during the plan, so the priority is checked again.
+	err = checkForwardingPolicyPriority(client, d.Get("listener_id").(string), id,
+		utils.PathSearch("l7policy.created_at", createRespBody, "").(string), d.Get("priority").(int))
+	if err != nil {
+		return diag.Errorf("error creating forwarding policy (%s): %s, please change the priority and apply again",
+			id, err)
+	}
+	return resourceForwardingPolicyRead(ctx, d, meta)
+}
+
+func getForwardingPolicyRules(client *golangsdk.ServiceClient, policyId string) ([]interface{}, error) {
+	listPath := client.Endpoint + "v3/{project_id}/elb/l7policies/{l7policy_id}/rules"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{l7policy_id}", policyId)
+
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	listResp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	listRespBody, err := utils.FlattenResponse(listResp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("rules", listRespBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+func flattenForwardingPolicyConditions(rules []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		conditions := utils.PathSearch("conditions", rule, make([]interface{}, 0)).([]interface{})
+		values := make([]interface{}, 0, len(conditions))
+		for _, condition := range conditions {
+			values = append(values, utils.PathSearch("value", condition, nil))
+		}
+		if len(values) < 1 {
+			values = append(values, utils.PathSearch("value", rule, nil))
+		}
+
+		result = append(result, map[string]interface{}{
+			"type":         utils.PathSearch("type", rule, nil),
+			"compare_type": utils.PathSearch("compare_type", rule, nil),
+			"invert":       utils.PathSearch("invert", rule, false),
+			"key":          utils.PathSearch("conditions[0].key", rule, nil),
+			"values":       values,
+		})
+	}
+	return result
+}
+
+func flattenForwardingPolicyForwardToPools(policy interface{}) []map[string]interface{} {
+	if utils.PathSearch("action", policy, "").(string) != "REDIRECT_TO_POOL" {
+		return nil
+	}
+
+	rawPools := utils.PathSearch("redirect_pools_config", policy, make([]interface{}, 0)).([]interface{})
+	pools := make([]map[string]interface{}, len(rawPools))
+	for i, pool := range rawPools {
+		pools[i] = map[string]interface{}{
+			"pool_id": utils.PathSearch("pool_id", pool, nil),
+			"weight":  utils.PathSearch("weight", pool, nil),
+		}
+	}
+
+	var rewriteUrl []map[string]interface{}
+	if utils.PathSearch("redirect_pools_extend_config.rewrite_url_enable", policy, false).(bool) {
+		rewriteUrl = []map[string]interface{}{
+			{
+				"host":  utils.PathSearch("redirect_pools_extend_config.rewrite_url_config.host", policy, nil),
+				"path":  utils.PathSearch("redirect_pools_extend_config.rewrite_url_config.path", policy, nil),
+				"query": utils.PathSearch("redirect_pools_extend_config.rewrite_url_config.query", policy, nil),
+			},
+		}
+	}
+
+	insertConfigs := utils.PathSearch("redirect_pools_extend_config.insert_headers_config.configs", policy,
+		make([]interface{}, 0)).([]interface{})
+	insertHeaders := make([]map[string]interface{}, len(insertConfigs))
+	for i, header := range insertConfigs {
+		insertHeaders[i] = map[string]interface{}{
+			"key":        utils.PathSearch("key", header, nil),
+			"value":      utils.PathSearch("value", header, nil),
+			"value_type": utils.PathSearch("value_type", header, nil),
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"pools":                  pools,
+			"sticky_session_enabled": utils.PathSearch("redirect_pools_sticky_session_config.enable", policy, false),
+			"sticky_session_timeout": utils.PathSearch("redirect_pools_sticky_session_config.timeout", policy, nil),
+			"rewrite_url":            rewriteUrl,
+			"insert_headers":         insertHeaders,
+			"remove_headers": utils.PathSearch("redirect_pools_extend_config.remove_headers_config.configs[*].key",
+				policy, nil),
+		},
+	}
+}
+
+func flattenForwardingPolicyRedirectToUrl(policy interface{}) []map[string]interface{} {
+	urlConfig := utils.PathSearch("redirect_url_config", policy, nil)
+	if urlConfig == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"status_code": utils.PathSearch("status_code", urlConfig, nil),
+			"protocol":    utils.PathSearch("protocol", urlConfig, nil),
+			"host":        utils.PathSearch("host", urlConfig, nil),
+			"port":        utils.PathSearch("port", urlConfig, nil),
+			"path":        utils.PathSearch("path", urlConfig, nil),
+			"query":       utils.PathSearch("query", urlConfig, nil),
+		},
+	}
+}
+
+func flattenForwardingPolicyFixedResponse(policy interface{}) []map[string]interface{} {
+	responseConfig := utils.PathSearch("fixed_response_config", policy, nil)
+	if responseConfig == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"status_code":  utils.PathSearch("status_code", responseConfig, nil),
+			"content_type": utils.PathSearch("content_type", responseConfig, nil),
+			"message_body": utils.PathSearch("message_body", responseConfig, nil),
+		},
+	}
+}
+
+func resourceForwardingPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("elb", region)
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/elb/l7policies/{l7policy_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{l7policy_id}", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving forwarding policy")
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rules, err := getForwardingPolicyRules(client, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving rules of forwarding policy (%s): %s", d.Id(), err)
+	}
+
+	policy := utils.PathSearch("l7policy", getRespBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("listener_id", utils.PathSearch("listener_id", policy, nil)),
+		d.Set("priority", utils.PathSearch("priority", policy, nil)),
+		d.Set("name", utils.PathSearch("name", policy, nil)),
+		d.Set("description", utils.PathSearch("description", policy, nil)),
+		d.Set("conditions", flattenForwardingPolicyConditions(rules)),
+		d.Set("forward_to_pools", flattenForwardingPolicyForwardToPools(policy)),
+		d.Set("redirect_to_listener_id", utils.PathSearch("redirect_listener_id", policy, nil)),
+		d.Set("redirect_to_url", flattenForwardingPolicyRedirectToUrl(policy)),
+		d.Set("fixed_response", flattenForwardingPolicyFixedResponse(policy)),
+		d.Set("action", utils.PathSearch("action", policy, nil)),
+		d.Set("status", utils.PathSearch("provisioning_status", policy, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceForwardingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"priority":    d.Get("priority"),
+	}
+	if d.HasChange("conditions") {
+		params["rules"] = buildForwardingPolicyRules(d)
+	}
+	if d.HasChanges("forward_to_pools", "redirect_to_listener_id", "redirect_to_url", "fixed_response") {
+		for k, v := range buildForwardingPolicyActionParams(d) {
+			// The action type is not changed, the policy is replaced if it is changed.
+			if k != "action" {
+				params[k] = v
+			}
+		}
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/elb/l7policies/{l7policy_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{l7policy_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"l7policy": utils.RemoveNil(params),
+		},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating forwarding policy (%s): %s", d.Id(), err)
+	}
+
+	err = waitForElbV3Policy(ctx, client, d.Id(), "ACTIVE", nil, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceForwardingPolicyRead(ctx, d, meta)
+}
+
+func resourceForwardingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/elb/l7policies/{l7policy_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{l7policy_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting forwarding policy")
+	}
+
+	log.Printf("[DEBUG] Waiting for the forwarding policy (%s) to be deleted", d.Id())
+	err = waitForElbV3Policy(ctx, client, d.Id(), "DELETED", nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,663 @@
+package elb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/instances"
+	"github.com/chnsz/golangsdk/openstack/ecs/v1/cloudservers"
+	"github.com/chnsz/golangsdk/pagination"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The maximum number of members which can be operated by one batch request.
+const maxMembersPerBatch = 100
+
+// @API ELB GET /v3/{project_id}/elb/pools/{pool_id}/members
+// @API ELB POST /v3/{project_id}/elb/pools/{pool_id}/members/batch-add
+// @API ELB POST /v3/{project_id}/elb/pools/{pool_id}/members/batch-update
+// @API ELB POST /v3/{project_id}/elb/pools/{pool_id}/members/batch-delete
+// @API ECS GET /v1/{project_id}/cloudservers/detail
+// @API AS GET /autoscaling-api/v1/{project_id}/scaling_group_instance/{scaling_group_id}/list
+func ResourceMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMembersCreate,
+		ReadContext:   resourceMembersRead,
+		UpdateContext: resourceMembersUpdate,
+		DeleteContext: resourceMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceMembersCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the pool whose members are managed.`,
+			},
+			"members": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"instance_selector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The IP address of the member.`,
+						},
+						"protocol_port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `The port used by the member to receive requests.`,
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The IPv4 or IPv6 subnet ID of the subnet in which to access the member.`,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  `The weight of the member.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the member.`,
+						},
+					},
+				},
+				Description: `The full set of the members of the pool.`,
+			},
+			"instance_selector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ExactlyOneOf: []string{"instance_selector.0.tags", "instance_selector.0.as_group_id"},
+							Description:  `The tags of the ECS instances to be registered as the members.`,
+						},
+						"as_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The ID of the AS group whose in-service instances are registered as the members.`,
+						},
+						"protocol_port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `The port used by the selected instances to receive requests.`,
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The IPv4 subnet ID of the subnet in which to access the selected instances.`,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  `The weight of the selected instances.`,
+						},
+					},
+				},
+				Description: `The selector of the ECS instances which are registered as the members.`,
+			},
+			"weight_rollout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"step": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  `The maximum weight change of a member in each step.`,
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `The interval between two steps, in seconds.`,
+						},
+					},
+				},
+				Description: `The configuration of rolling out the weight changes of the members gradually.`,
+			},
+		},
+	}
+}
+
+type memberWeightChange struct {
+	id     string
+	name   string
+	from   int
+	target int
+}
+
+func memberKey(address string, protocolPort int, subnetId string) string {
+	return fmt.Sprintf("%s:%d/%s", address, protocolPort, subnetId)
+}
+
+func memberKeyOf(member map[string]interface{}) string {
+	return memberKey(member["address"].(string), member["protocol_port"].(int), member["subnet_id"].(string))
+}
+
+func listPoolMembers(client *golangsdk.ServiceClient, poolId string) ([]interface{}, error) {
+	listPath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{pool_id}", poolId)
+
+	listResp, err := pagination.ListAllItems(
+		client,
+		"marker",
+		listPath,
+		&pagination.QueryOpts{MarkerField: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	listRespJson, err := json.Marshal(listResp)
+	if err != nil {
+		return nil, err
+	}
+	var listRespBody interface{}
+	err = json.Unmarshal(listRespJson, &listRespBody)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("members", listRespBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+func doMembersBatchAction(client *golangsdk.ServiceClient, poolId, action string, members []map[string]interface{}) error {
+	actionPath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members/{action}"
+	actionPath = strings.ReplaceAll(actionPath, "{project_id}", client.ProjectID)
+	actionPath = strings.ReplaceAll(actionPath, "{pool_id}", poolId)
+	actionPath = strings.ReplaceAll(actionPath, "{action}", action)
+
+	for start := 0; start < len(members); start += maxMembersPerBatch {
+		end := start + maxMembersPerBatch
+		if end > len(members) {
+			end = len(members)
+		}
+
+		actionOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"members": members[start:end],
+			},
+		}
+		_, err := client.Request("POST", actionPath, &actionOpt)
+		if err != nil {
+			return fmt.Errorf("error executing %s of the members of pool (%s): %s", action, poolId, err)
+		}
+	}
+	return nil
+}
+
+type selectedServersPage struct {
+	Count   int                        `json:"count"`
+	Servers []cloudservers.CloudServer `json:"servers"`
+}
+
+// queryServers lists all ACTIVE ECS instances which match the query parameters.
+func queryServers(client *golangsdk.ServiceClient, params url.Values) ([]cloudservers.CloudServer, error) {
+	params.Set("status", "ACTIVE")
+	params.Set("limit", strconv.Itoa(maxMembersPerBatch))
+
+	result := make([]cloudservers.CloudServer, 0)
+	for pageNum := 1; ; pageNum++ {
+		params.Set("offset", strconv.Itoa(pageNum))
+		var page selectedServersPage
+		_, err := client.Get(client.ServiceURL("cloudservers", "detail")+"?"+params.Encode(), &page, nil)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.Servers...)
+		if len(page.Servers) < maxMembersPerBatch || len(result) >= page.Count {
+			return result, nil
+		}
+	}
+}
+
+func listSelectedServers(cfg *config.Config, region string, selector map[string]interface{}) ([]cloudservers.CloudServer,
+	error) {
+	ecsClient, err := cfg.ComputeV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ECS client: %s", err)
+	}
+
+	if groupId := selector["as_group_id"].(string); groupId != "" {
+		asClient, err := cfg.AutoscalingV1Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating autoscaling client: %s", err)
+		}
+		page, err := instances.List(asClient, groupId, instances.ListOpts{LifeCycleStatus: "INSERVICE"}).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving instances of AS group (%s): %s", groupId, err)
+		}
+		groupInstances, err := page.(instances.InstancePage).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the instances of the AS group are queried, the IDs are queried in batches.
+		result := make([]cloudservers.CloudServer, 0, len(groupInstances))
+		for start := 0; start < len(groupInstances); start += maxMembersPerBatch {
+			end := start + maxMembersPerBatch
+			if end > len(groupInstances) {
+				end = len(groupInstances)
+			}
+			instanceIds := make([]string, 0, end-start)
+			for _, instance := range groupInstances[start:end] {
+				instanceIds = append(instanceIds, instance.ID)
+			}
+
+			params := url.Values{}
+			params.Set("server_id", strings.Join(instanceIds, ","))
+			servers, err := queryServers(ecsClient, params)
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving ECS instances of AS group (%s): %s", groupId, err)
+			}
+			result = append(result, servers...)
+		}
+		return result, nil
+	}
+
+	tagList := make([]string, 0)
+	for k, v := range selector["tags"].(map[string]interface{}) {
+		tagList = append(tagList, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(tagList)
+
+	params := url.Values{}
+	params.Set("tags", strings.Join(tagList, ","))
+	servers, err := queryServers(ecsClient, params)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ECS instances: %s", err)
+	}
+
+	// The tags are filtered on the server side, all of them are checked again to make sure they are matched exactly.
+	result := make([]cloudservers.CloudServer, 0, len(servers))
+	for _, server := range servers {
+		if utils.StrSliceContainsAnother(server.Tags, tagList) {
+			result = append(result, server)
+		}
+	}
+	return result, nil
+}
+
+// getServerFixedIPv4Address returns the primary private IPv4 address of the ECS instance.
+func getServerFixedIPv4Address(server cloudservers.CloudServer) string {
+	for _, addresses := range server.Addresses {
+		for _, address := range addresses {
+			if address.Type == "fixed" && address.Version == "4" {
+				return address.Addr
+			}
+		}
+	}
+	return ""
+}
+
+// resolveSelectedMembers returns the members expected by the instance selector.
+func resolveSelectedMembers(cfg *config.Config, region string, selector map[string]interface{}) ([]interface{}, error) {
+	servers, err := listSelectedServers(cfg, region, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		address := getServerFixedIPv4Address(server)
+		if address == "" {
+			log.Printf("[WARN] unable to find the private IPv4 address of the ECS instance (%s), skip it", server.ID)
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"address":       address,
+			"protocol_port": selector["protocol_port"],
+			"subnet_id":     selector["subnet_id"],
+			"weight":        selector["weight"],
+			"name":          server.Name,
+		})
+	}
+	return result, nil
+}
+
+func resourceMembersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawSelector := d.Get("instance_selector").([]interface{})
+	if len(rawSelector) < 1 || rawSelector[0] == nil {
+		return nil
+	}
+	if !d.NewValueKnown("instance_selector") {
+		return d.SetNewComputed("members")
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	members, err := resolveSelectedMembers(cfg, region, rawSelector[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	return d.SetNew("members", members)
+}
+
+// getExpectedMembers returns the members expected by the configuration, the instance selector is resolved again to
+// register the instances which are created after the plan.
+func getExpectedMembers(cfg *config.Config, d *schema.ResourceData) ([]interface{}, error) {
+	rawSelector := d.Get("instance_selector").([]interface{})
+	if len(rawSelector) < 1 || rawSelector[0] == nil {
+		return d.Get("members").(*schema.Set).List(), nil
+	}
+	return resolveSelectedMembers(cfg, cfg.GetRegion(d), rawSelector[0].(map[string]interface{}))
+}
+
+func rolloutMemberWeights(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	changes []memberWeightChange) error {
+	poolId := d.Get("pool_id").(string)
+	step, interval := 100, 0
+	if rawArray := d.Get("weight_rollout").([]interface{}); len(rawArray) > 0 && rawArray[0] != nil {
+		raw := rawArray[0].(map[string]interface{})
+		step = raw["step"].(int)
+		interval = raw["interval"].(int)
+	}
+
+	for len(changes) > 0 {
+		params := make([]map[string]interface{}, 0, len(changes))
+		remaining := make([]memberWeightChange, 0)
+		for _, change := range changes {
+			next := change.target
+			if change.target-change.from > step {
+				next = change.from + step
+			} else if change.from-change.target > step {
+				next = change.from - step
+			}
+			params = append(params, map[string]interface{}{
+				"id":     change.id,
+				"name":   change.name,
+				"weight": next,
+			})
+
+			if next != change.target {
+				change.from = next
+				remaining = append(remaining, change)
+			}
+		}
+
+		if err := doMembersBatchAction(client, poolId, "batch-update", params); err != nil {
+			return err
+		}
+		if len(remaining) < 1 {
+			break
+		}
+
+		log.Printf("[DEBUG] The weights of %d members of pool (%s) are still rolling out", len(remaining), poolId)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error rolling out the weights of the members of pool (%s): %s", poolId, ctx.Err())
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+		changes = remaining
+	}
+	return nil
+}
+
+// syncMembers adds, updates and removes the members of the pool to make them match the expected members.
+func syncMembers(ctx context.Context, cfg *config.Config, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	poolId := d.Get("pool_id").(string)
+	expectedMembers, err := getExpectedMembers(cfg, d)
+	if err != nil {
+		return err
+	}
+	currentMembers, err := listPoolMembers(client, poolId)
+	if err != nil {
+		return fmt.Errorf("error retrieving members of pool (%s): %s", poolId, err)
+	}
+
+	currentMap := make(map[string]interface{}, len(currentMembers))
+	for _, member := range currentMembers {
+		key := memberKey(utils.PathSearch("address", member, "").(string),
+			int(utils.PathSearch("protocol_port", member, float64(0)).(float64)),
+			utils.PathSearch("subnet_cidr_id", member, "").(string))
+		currentMap[key] = member
+	}
+
+	var (
+		isRollout    = len(d.Get("weight_rollout").([]interface{})) > 0
+		addParams    = make([]map[string]interface{}, 0)
+		addedMembers = make(map[string]map[string]interface{})
+		changes      = make([]memberWeightChange, 0)
+		expected     = make(map[string]bool, len(expectedMembers))
+	)
+	for _, v := range expectedMembers {
+		member := v.(map[string]interface{})
+		key := memberKeyOf(member)
+		expected[key] = true
+
+		current, ok := currentMap[key]
+		if !ok {
+			// With the weight rollout, the new members are registered without weight and rolled up after the adding.
+			weight := member["weight"].(int)
+			if isRollout {
+				addedMembers[key] = member
+				weight = 0
+			}
+			addParams = append(addParams, utils.RemoveNil(map[string]interface{}{
+				"address":        member["address"],
+				"protocol_port":  member["protocol_port"],
+				"subnet_cidr_id": utils.ValueIngoreEmpty(member["subnet_id"]),
+				"weight":         weight,
+				"name":           utils.ValueIngoreEmpty(member["name"]),
+			}))
+			continue
+		}
+
+		currentWeight := int(utils.PathSearch("weight", current, float64(0)).(float64))
+		currentName := utils.PathSearch("name", current, "").(string)
+		if currentWeight != member["weight"].(int) || currentName != member["name"].(string) {
+			changes = append(changes, memberWeightChange{
+				id:     utils.PathSearch("id", current, "").(string),
+				name:   member["name"].(string),
+				from:   currentWeight,
+				target: member["weight"].(int),
+			})
+		}
+	}
+
+	deleteParams := make([]map[string]interface{}, 0)
+	for key, member := range currentMap {
+		if !expected[key] {
+			deleteParams = append(deleteParams, map[string]interface{}{
+				"id": utils.PathSearch("id", member, nil),
+			})
+		}
+	}
+
+	log.Printf("[DEBUG] Syncing members of pool (%s): %d to add, %d to update, %d to delete", poolId,
+		len(addParams), len(changes), len(deleteParams))
+	if err = doMembersBatchAction(client, poolId, "batch-delete", deleteParams); err != nil {
+		return err
+	}
+	if err = doMembersBatchAction(client, poolId, "batch-add", addParams); err != nil {
+		return err
+	}
+
+	if len(addedMembers) > 0 {
+		addedChanges, err := getAddedMemberWeightChanges(client, poolId, addedMembers)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, addedChanges...)
+	}
+	return rolloutMemberWeights(ctx, client, d, changes)
+}
+
+// getAddedMemberWeightChanges returns the weight changes which roll up the members added without weight to their
+// target weights.
+func getAddedMemberWeightChanges(client *golangsdk.ServiceClient, poolId string,
+	addedMembers map[string]map[string]interface{}) ([]memberWeightChange, error) {
+	members, err := listPoolMembers(client, poolId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving members of pool (%s): %s", poolId, err)
+	}
+
+	result := make([]memberWeightChange, 0, len(addedMembers))
+	for _, current := range members {
+		key := memberKey(utils.PathSearch("address", current, "").(string),
+			int(utils.PathSearch("protocol_port", current, float64(0)).(float64)),
+			utils.PathSearch("subnet_cidr_id", current, "").(string))
+		member, ok := addedMembers[key]
+		if !ok || member["weight"].(int) == 0 {
+			continue
+		}
+		result = append(result, memberWeightChange{
+			id:     utils.PathSearch("id", current, "").(string),
+			name:   member["name"].(string),
+			from:   0,
+			target: member["weight"].(int),
+		})
+	}
+	return result, nil
+}
+
+func resourceMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	if err = syncMembers(ctx, cfg, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("pool_id").(string))
+
+	return resourceMembersRead(ctx, d, meta)
+}
+
+func flattenManagedMembers(members []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(members))
+	for i, member := range members {
+		result[i] = map[string]interface{}{
+			"address":       utils.PathSearch("address", member, nil),
+			"protocol_port": utils.PathSearch("protocol_port", member, nil),
+			"subnet_id":     utils.PathSearch("subnet_cidr_id", member, nil),
+			"weight":        utils.PathSearch("weight", member, nil),
+			"name":          utils.PathSearch("name", member, nil),
+		}
+	}
+	return result
+}
+
+func resourceMembersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("elb", region)
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	members, err := listPoolMembers(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving members")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("pool_id", d.Id()),
+		d.Set("members", flattenManagedMembers(members)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	if err = syncMembers(ctx, cfg, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceMembersRead(ctx, d, meta)
+}
+
+func resourceMembersDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	members, err := listPoolMembers(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving members")
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		managed[memberKeyOf(v.(map[string]interface{}))] = true
+	}
+	deleteParams := make([]map[string]interface{}, 0)
+	for _, member := range members {
+		key := memberKey(utils.PathSearch("address", member, "").(string),
+			int(utils.PathSearch("protocol_port", member, float64(0)).(float64)),
+			utils.PathSearch("subnet_cidr_id", member, "").(string))
+		if managed[key] {
+			deleteParams = append(deleteParams, map[string]interface{}{
+				"id": utils.PathSearch("id", member, nil),
+			})
+		}
+	}
+
+	if err = doMembersBatchAction(client, d.Id(), "batch-delete", deleteParams); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
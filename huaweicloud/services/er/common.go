@@ -0,0 +1,306 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/er/v3/attachments"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The VPN, VGW (virtual gateway of Direct Connect) and peering attachments are created by the VPN, DC and CC services.
+// The typed attachment resources take over an existing attachment of the specified resource, manage its name,
+// description and tags, and leave the attachment to the owner service when they are destroyed.
+
+func typedAttachmentSchema(resourceType, resourceIdDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf(`The region where the ER instance and the %s attachment are located.`, resourceType),
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf(`The ID of the ER instance to which the %s attachment belongs.`, resourceType),
+		},
+		"resource_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: resourceIdDescription,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 64),
+				validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa5\\w.-]*$"), "The name only english and "+
+					"chinese letters, digits, underscore (_), hyphens (-) and dots (.) are allowed."),
+			),
+			Description: fmt.Sprintf(`The name of the %s attachment.`, resourceType),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(0, 255),
+				validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+					"The angle brackets (< and >) are not allowed."),
+			),
+			Description: fmt.Sprintf(`The description of the %s attachment.`, resourceType),
+		},
+		"tags": common.TagsSchema(),
+		// Attributes
+		"resource_project_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The project ID to which the associated resource belongs.`,
+		},
+		"associated": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: `Whether the attachment has been associated with a route table.`,
+		},
+		"route_table_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The ID of the associated route table.`,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf(`The current status of the %s attachment.`, resourceType),
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The creation time.`,
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The latest update time.`,
+		},
+	}
+}
+
+func typedAttachmentRefreshFunc(client *golangsdk.ServiceClient, instanceId, resourceType,
+	resourceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		opts := attachments.ListOpts{
+			ResourceTypes: []string{resourceType},
+			ResourceIds:   []string{resourceId},
+		}
+		resp, err := attachments.List(client, instanceId, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(resp) < 1 {
+			log.Printf("[DEBUG] The %s attachment of the resource (%s) has not been created", resourceType, resourceId)
+			return resp, "PENDING", nil
+		}
+
+		attachment := resp[0]
+		if utils.StrSliceContains([]string{"failed", "rejected"}, attachment.Status) {
+			return attachment, "", fmt.Errorf("unexpected status '%s'", attachment.Status)
+		}
+		if attachment.Status == "available" {
+			return attachment, "COMPLETED", nil
+		}
+		return attachment, "PENDING", nil
+	}
+}
+
+func getTypedAttachment(client *golangsdk.ServiceClient, instanceId, attachmentId string) (interface{}, error) {
+	getPath := client.ResourceBaseURL() + "enterprise-router/{er_id}/attachments/{attachment_id}"
+	getPath = strings.ReplaceAll(getPath, "{er_id}", instanceId)
+	getPath = strings.ReplaceAll(getPath, "{attachment_id}", attachmentId)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("attachment", getRespBody, nil), nil
+}
+
+func updateTypedAttachmentBasicInfo(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	resourceType string, timeout time.Duration) error {
+	var (
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Id()
+	)
+
+	updatePath := client.ResourceBaseURL() + "enterprise-router/{er_id}/attachments/{attachment_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{er_id}", instanceId)
+	updatePath = strings.ReplaceAll(updatePath, "{attachment_id}", attachmentId)
+
+	params := map[string]interface{}{
+		"name": utils.ValueIngoreEmpty(d.Get("name")),
+	}
+	// The description set by the owner service is kept unless it is changed.
+	if d.HasChange("description") {
+		params["description"] = d.Get("description")
+	}
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"attachment": utils.RemoveNil(params),
+		},
+	}
+	_, err := client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating %s attachment (%s): %s", resourceType, attachmentId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      typedAttachmentRefreshFunc(client, instanceId, resourceType, d.Get("resource_id").(string)),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// createTypedAttachment waits for the attachment of the resource to be created by the owner service and takes it over.
+func createTypedAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceType string) error {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	var (
+		instanceId = d.Get("instance_id").(string)
+		resourceId = d.Get("resource_id").(string)
+	)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      typedAttachmentRefreshFunc(client, instanceId, resourceType, resourceId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	resp, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the %s attachment of the resource (%s) to become available: %s",
+			resourceType, resourceId, err)
+	}
+	d.SetId(resp.(attachments.Attachment).ID)
+
+	_, nameOk := d.GetOk("name")
+	_, descOk := d.GetOk("description")
+	if nameOk || descOk {
+		if err = updateTypedAttachmentBasicInfo(ctx, client, d, resourceType, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("tags"); ok {
+		err = utils.UpdateResourceTags(client, d, resourceType+"-attachment", d.Id())
+		if err != nil {
+			return fmt.Errorf("error setting tags of %s attachment (%s): %s", resourceType, d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func readTypedAttachment(d *schema.ResourceData, meta interface{}, resourceType string) diag.Diagnostics {
+	var (
+		cfg          = meta.(*config.Config)
+		region       = cfg.GetRegion(d)
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Id()
+	)
+
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	attachment, err := getTypedAttachment(client, instanceId, attachmentId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, fmt.Sprintf("ER %s attachment", resourceType))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("resource_id", utils.PathSearch("resource_id", attachment, nil)),
+		d.Set("name", utils.PathSearch("name", attachment, nil)),
+		d.Set("description", utils.PathSearch("description", attachment, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("tags", attachment, nil))),
+		d.Set("resource_project_id", utils.PathSearch("resource_project_id", attachment, nil)),
+		d.Set("associated", utils.PathSearch("associated", attachment, nil)),
+		d.Set("route_table_id", utils.PathSearch("route_table_id", attachment, nil)),
+		d.Set("status", utils.PathSearch("state", attachment, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", attachment, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", attachment, nil)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving %s attachment (%s) fields: %s", resourceType, d.Id(), mErr)
+	}
+	return nil
+}
+
+func updateTypedAttachment(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceType string) error {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ErV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		if err = updateTypedAttachmentBasicInfo(ctx, client, d, resourceType, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		err = utils.UpdateResourceTags(client, d, resourceType+"-attachment", d.Id())
+		if err != nil {
+			return fmt.Errorf("error updating %s attachment tags: %s", resourceType, err)
+		}
+	}
+	return nil
+}
+
+func resourceTypedAttachmentImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<attachment_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
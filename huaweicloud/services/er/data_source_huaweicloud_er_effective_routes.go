@@ -0,0 +1,217 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API ER GET /v3/{project_id}/enterprise-router/route-tables/{route_table_id}/routes
+func DataSourceEffectiveRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEffectiveRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the route table is located.`,
+			},
+			"route_table_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the route table to which the effective routes belong.`,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The destination address (CIDR) used to filter the effective routes.`,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "vpn", "vgw", "peering",
+				}, false),
+				Description: `The attachment type of the next hops used to filter the effective routes.`,
+			},
+			"route_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"static", "propagated",
+				}, false),
+				Description: `The route type used to filter the effective routes.`,
+			},
+			// Attributes
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The route ID.`,
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The destination address (CIDR) of the route.`,
+						},
+						"route_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the route.`,
+						},
+						"is_blackhole": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether route is the black hole route.`,
+						},
+						"next_hops": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attachment_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The ID of the nexthop attachment.`,
+									},
+									"resource_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The ID of the resource associated with the attachment.`,
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The type of the resource associated with the attachment.`,
+									},
+								},
+							},
+							Description: `The next hops of the route.`,
+						},
+					},
+				},
+				Description: `All effective routes that match the filter parameters.`,
+			},
+		},
+	}
+}
+
+func buildEffectiveRoutesQueryParams(d *schema.ResourceData) string {
+	params := url.Values{}
+	params.Add("limit", "100")
+	if v, ok := d.GetOk("destination"); ok {
+		params.Add("destination", v.(string))
+	}
+	if v, ok := d.GetOk("resource_type"); ok {
+		params.Add("resource_type", v.(string))
+	}
+	return params.Encode()
+}
+
+func listEffectiveRoutes(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]interface{}, error) {
+	routeTableId := d.Get("route_table_id").(string)
+	listPath := client.ResourceBaseURL() + "enterprise-router/route-tables/{route_table_id}/routes"
+	listPath = strings.ReplaceAll(listPath, "{route_table_id}", routeTableId)
+	listPath += "?" + buildEffectiveRoutesQueryParams(d)
+
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	var (
+		result = make([]interface{}, 0)
+		marker string
+	)
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath += "&marker=" + url.QueryEscape(marker)
+		}
+		listResp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving effective routes of route table (%s): %s", routeTableId, err)
+		}
+		listRespBody, err := utils.FlattenResponse(listResp)
+		if err != nil {
+			return nil, err
+		}
+
+		routes := utils.PathSearch("routes", listRespBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, routes...)
+		marker = utils.PathSearch("page_info.next_marker", listRespBody, "").(string)
+		if marker == "" || len(routes) < 1 {
+			break
+		}
+	}
+	return result, nil
+}
+
+func flattenEffectiveRoutes(routes []interface{}, routeType string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(routes))
+	for _, route := range routes {
+		if routeType != "" && utils.PathSearch("route_type", route, "").(string) != routeType {
+			continue
+		}
+
+		nextHops := utils.PathSearch("next_hops", route, make([]interface{}, 0)).([]interface{})
+		hops := make([]map[string]interface{}, len(nextHops))
+		for i, hop := range nextHops {
+			hops[i] = map[string]interface{}{
+				"attachment_id": utils.PathSearch("attachment_id", hop, nil),
+				"resource_id":   utils.PathSearch("resource_id", hop, nil),
+				"resource_type": utils.PathSearch("resource_type", hop, nil),
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"route_id":     utils.PathSearch("route_id", route, nil),
+			"destination":  utils.PathSearch("destination", route, nil),
+			"route_type":   utils.PathSearch("route_type", route, nil),
+			"is_blackhole": utils.PathSearch("is_blackhole", route, false),
+			"next_hops":    hops,
+		})
+	}
+	return result
+}
+
+func dataSourceEffectiveRoutesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ErV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routes, err := listEffectiveRoutes(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	randomUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randomUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("routes", flattenEffectiveRoutes(routes, d.Get("route_type").(string))),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,59 @@
+package er
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @API ER GET /v3/{project_id}/enterprise-router/{er_id}/attachments
+// @API ER GET /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+// @API ER PUT /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+// @API ER POST /v3/{project_id}/vgw-attachment/{id}/tags/action
+func ResourceDcAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcAttachmentCreate,
+		UpdateContext: resourceDcAttachmentUpdate,
+		ReadContext:   resourceDcAttachmentRead,
+		DeleteContext: resourceDcAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTypedAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: typedAttachmentSchema("DC",
+			`The ID of the DC virtual gateway (which is associated with the ER instance) to which the attachment belongs.`),
+	}
+}
+
+func resourceDcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createTypedAttachment(ctx, d, meta, "vgw"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceDcAttachmentRead(ctx, d, meta)
+}
+
+func resourceDcAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readTypedAttachment(d, meta, "vgw")
+}
+
+func resourceDcAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateTypedAttachment(ctx, d, meta, "vgw"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceDcAttachmentRead(ctx, d, meta)
+}
+
+func resourceDcAttachmentDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The DC attachment (%s) is removed from the state only, it will be deleted with the DC "+
+		"virtual gateway", d.Id())
+	return nil
+}
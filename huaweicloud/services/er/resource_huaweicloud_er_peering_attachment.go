@@ -0,0 +1,60 @@
+package er
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @API ER GET /v3/{project_id}/enterprise-router/{er_id}/attachments
+// @API ER GET /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+// @API ER PUT /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+// @API ER POST /v3/{project_id}/peering-attachment/{id}/tags/action
+func ResourcePeeringAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePeeringAttachmentCreate,
+		UpdateContext: resourcePeeringAttachmentUpdate,
+		ReadContext:   resourcePeeringAttachmentRead,
+		DeleteContext: resourcePeeringAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTypedAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: typedAttachmentSchema("peering",
+			`The ID of the peering connection, which is built by the central network of Cloud Connect between the ER `+
+				`instances in different regions.`),
+	}
+}
+
+func resourcePeeringAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createTypedAttachment(ctx, d, meta, "peering"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePeeringAttachmentRead(ctx, d, meta)
+}
+
+func resourcePeeringAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readTypedAttachment(d, meta, "peering")
+}
+
+func resourcePeeringAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateTypedAttachment(ctx, d, meta, "peering"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePeeringAttachmentRead(ctx, d, meta)
+}
+
+func resourcePeeringAttachmentDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The peering attachment (%s) is removed from the state only, it will be deleted when the ER "+
+		"instance is removed from the central network policy", d.Id())
+	return nil
+}
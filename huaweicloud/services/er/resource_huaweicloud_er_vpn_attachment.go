@@ -0,0 +1,59 @@
+package er
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @API ER GET /v3/{project_id}/enterprise-router/{er_id}/attachments
+// @API ER GET /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+// @API ER PUT /v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}
+// @API ER POST /v3/{project_id}/vpn-attachment/{id}/tags/action
+func ResourceVpnAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpnAttachmentCreate,
+		UpdateContext: resourceVpnAttachmentUpdate,
+		ReadContext:   resourceVpnAttachmentRead,
+		DeleteContext: resourceVpnAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTypedAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: typedAttachmentSchema("VPN",
+			`The ID of the VPN connection (whose gateway is attached to the ER instance) to which the attachment belongs.`),
+	}
+}
+
+func resourceVpnAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createTypedAttachment(ctx, d, meta, "vpn"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceVpnAttachmentRead(ctx, d, meta)
+}
+
+func resourceVpnAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readTypedAttachment(d, meta, "vpn")
+}
+
+func resourceVpnAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateTypedAttachment(ctx, d, meta, "vpn"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceVpnAttachmentRead(ctx, d, meta)
+}
+
+func resourceVpnAttachmentDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The VPN attachment (%s) is removed from the state only, it will be deleted with the VPN "+
+		"connection", d.Id())
+	return nil
+}
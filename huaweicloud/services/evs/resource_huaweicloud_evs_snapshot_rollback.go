@@ -0,0 +1,144 @@
+package evs
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API EVS POST /v2/{project_id}/cloudsnapshots/{snapshot_id}/rollback
+// @API EVS GET /v2/{project_id}/cloudsnapshots/{snapshot_id}
+// @API EVS GET /v2/{project_id}/cloudvolumes/{volume_id}
+func ResourceEvsSnapshotRollback() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEvsSnapshotRollbackCreate,
+		ReadContext:   resourceEvsSnapshotRollbackRead,
+		DeleteContext: resourceEvsSnapshotRollbackDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func buildSnapshotRollbackBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"rollback": map[string]interface{}{
+			"volume_id": d.Get("volume_id"),
+			"name":      utils.ValueIngoreEmpty(d.Get("name")),
+		},
+	}
+}
+
+func resourceEvsSnapshotRollbackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("evs", region)
+	if err != nil {
+		return diag.Errorf("error creating EVS client: %s", err)
+	}
+	evsV2Client, err := cfg.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating block storage v2 client: %s", err)
+	}
+
+	var (
+		snapshotId = d.Get("snapshot_id").(string)
+		volumeId   = d.Get("volume_id").(string)
+	)
+	rollbackHttpUrl := "v2/{project_id}/cloudsnapshots/{snapshot_id}/rollback"
+	rollbackPath := client.Endpoint + rollbackHttpUrl
+	rollbackPath = strings.ReplaceAll(rollbackPath, "{project_id}", client.ProjectID)
+	rollbackPath = strings.ReplaceAll(rollbackPath, "{snapshot_id}", snapshotId)
+	rollbackOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildSnapshotRollbackBodyParams(d)),
+	}
+	_, err = client.Request("POST", rollbackPath, &rollbackOpt)
+	if err != nil {
+		return diag.Errorf("error rolling back EVS snapshot (%s) to volume (%s): %s", snapshotId, volumeId, err)
+	}
+	// The same snapshot can be rolled back more than once, so the snapshot ID can not be used as the resource ID.
+	resourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(resourceId)
+
+	log.Printf("[DEBUG] Waiting for the EVS volume (%s) rollback to complete", volumeId)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      refreshVolumeStatusFunc(evsV2Client, volumeId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the EVS volume (%s) rollback to complete: %s", volumeId, err)
+	}
+
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"rollbacking"},
+		Target:     []string{"available"},
+		Refresh:    snapshotStateRefreshFunc(evsV2Client, snapshotId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the EVS snapshot (%s) to become available: %s", snapshotId, err)
+	}
+
+	return nil
+}
+
+func resourceEvsSnapshotRollbackRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceEvsSnapshotRollbackDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting EVS snapshot rollback is not supported. The rollback is only removed from the state, " +
+		"and the volume data will not be restored."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -36,6 +36,8 @@ import (
 // @API EVS DELETE /v2/{project_id}/cloudvolumes/{id}
 // @API EVS POST /v2.1/{project_id}/cloudvolumes
 // @API EVS PUT /v5/{project_id}/cloudvolumes/{volume_id}/qos
+// @API EVS GET /v2/{project_id}/types
+// @API EVS POST /v2/{project_id}/volumes/{volume_id}/retype
 // @API ECS DELETE /v1/{project_id}/cloudservers/{serverId}/detachvolume/{volumeId}
 // @API ECS GET /v1/{project_id}/jobs/{job_id}
 // @API BSS GET /v2/orders/customer-orders/details/{order_id}
@@ -54,6 +56,8 @@ func ResourceEvsVolume() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceEvsVolumeCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(3 * time.Minute),
@@ -75,7 +79,6 @@ func ResourceEvsVolume() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"server_id": {
 				Type:     schema.TypeString,
@@ -510,6 +513,180 @@ func modifyQoS(ctx context.Context, client *golangsdk.ServiceClient, d *schema.R
 	return nil
 }
 
+// checkVolumeTypeAvailable checks whether the volume type exists and is sold in the availability zone of the volume.
+func checkVolumeTypeAvailable(client *golangsdk.ServiceClient, volumeType, availabilityZone string) error {
+	listTypesHttpUrl := "v2/{project_id}/types"
+	listTypesPath := client.Endpoint + listTypesHttpUrl
+	listTypesPath = strings.ReplaceAll(listTypesPath, "{project_id}", client.ProjectID)
+	listTypesOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	listTypesResp, err := client.Request("GET", listTypesPath, &listTypesOpt)
+	if err != nil {
+		return fmt.Errorf("error querying EVS volume types: %s", err)
+	}
+	listTypesRespBody, err := utils.FlattenResponse(listTypesResp)
+	if err != nil {
+		return err
+	}
+
+	expression := fmt.Sprintf("volume_types[?name=='%s']|[0]", volumeType)
+	volumeTypeDetail := utils.PathSearch(expression, listTypesRespBody, nil)
+	if volumeTypeDetail == nil {
+		return fmt.Errorf("the volume type (%s) does not exist", volumeType)
+	}
+
+	availabilityZones := utils.PathSearch(`extra_specs."RESKEY:availability_zones"`, volumeTypeDetail, "").(string)
+	if !utils.StrSliceContains(strings.Split(availabilityZones, ","), availabilityZone) {
+		return fmt.Errorf("the volume type (%s) is not supported in the availability zone (%s)", volumeType,
+			availabilityZone)
+	}
+	soldOutZones := utils.PathSearch(`extra_specs."os-vendor-extended:sold_out_availability_zones"`,
+		volumeTypeDetail, "").(string)
+	if utils.StrSliceContains(strings.Split(soldOutZones, ","), availabilityZone) {
+		return fmt.Errorf("the volume type (%s) is sold out in the availability zone (%s)", volumeType,
+			availabilityZone)
+	}
+	return nil
+}
+
+// The volume types which support the custom IOPS and throughput.
+var (
+	volumeTypesWithIops       = []string{"GPSSD2", "ESSD2"}
+	volumeTypesWithThroughput = []string{"GPSSD2"}
+)
+
+// resourceEvsVolumeCustomizeDiff checks whether the new volume type is available in the AZ of the volume before the
+// type is changed in place, and recomputes the IOPS and throughput which are not configured.
+func resourceEvsVolumeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("volume_type") || !d.NewValueKnown("volume_type") {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	for _, key := range []string{"iops", "throughput"} {
+		if rawConfig.GetAttr(key).IsNull() {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := cfg.NewServiceClient("evs", region)
+	if err != nil {
+		return fmt.Errorf("error creating EVS client: %s", err)
+	}
+	return checkVolumeTypeAvailable(client, d.Get("volume_type").(string), d.Get("availability_zone").(string))
+}
+
+func buildVolumeRetypeBodyParams(d *schema.ResourceData) map[string]interface{} {
+	volumeType := d.Get("volume_type").(string)
+	retypeParams := map[string]interface{}{
+		"new_type": volumeType,
+	}
+	// The IOPS and throughput of the old type are not sent if the new type does not support them.
+	if utils.StrSliceContains(volumeTypesWithIops, volumeType) {
+		retypeParams["iops"] = utils.ValueIngoreEmpty(d.Get("iops"))
+	}
+	if utils.StrSliceContains(volumeTypesWithThroughput, volumeType) {
+		retypeParams["throughput"] = utils.ValueIngoreEmpty(d.Get("throughput"))
+	}
+
+	bodyParams := map[string]interface{}{
+		"os-retype": retypeParams,
+	}
+	if strings.EqualFold(d.Get("charging_mode").(string), "prePaid") {
+		bodyParams["bssParam"] = map[string]interface{}{
+			"isAutoPay": common.GetAutoPay(d),
+		}
+	}
+	return bodyParams
+}
+
+func modifyVolumeType(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	cfg *config.Config) error {
+	region := cfg.GetRegion(d)
+	volumeType := d.Get("volume_type").(string)
+	evsClient, err := cfg.NewServiceClient("evs", region)
+	if err != nil {
+		return fmt.Errorf("error creating EVS client: %s", err)
+	}
+
+	// Interface constraints: the type can be changed only when the volume status is available or in-use
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      refreshVolumeStatusFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for EVS volume (%s) to become ready: %s", d.Id(), err)
+	}
+
+	retypeHttpUrl := "v2/{project_id}/volumes/{volume_id}/retype"
+	retypePath := evsClient.Endpoint + retypeHttpUrl
+	retypePath = strings.ReplaceAll(retypePath, "{project_id}", evsClient.ProjectID)
+	retypePath = strings.ReplaceAll(retypePath, "{volume_id}", d.Id())
+	retypeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildVolumeRetypeBodyParams(d)),
+	}
+	retypeResp, err := evsClient.Request("POST", retypePath, &retypeOpt)
+	if err != nil {
+		return fmt.Errorf("error changing the type of EVS volume (%s) to %s: %s", d.Id(), volumeType, err)
+	}
+	retypeRespBody, err := utils.FlattenResponse(retypeResp)
+	if err != nil {
+		return err
+	}
+
+	// If charging mode is PrePaid, wait for the order to be completed.
+	if orderId := utils.PathSearch("order_id", retypeRespBody, "").(string); orderId != "" {
+		bssClient, err := cfg.BssV2Client(region)
+		if err != nil {
+			return fmt.Errorf("error creating BSS v2 client: %s", err)
+		}
+		err = common.WaitOrderComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("the order (%s) is not completed while changing the type of EVS volume (%s): %#v",
+				orderId, d.Id(), err)
+		}
+	}
+
+	if jobId := utils.PathSearch("job_id", retypeRespBody, "").(string); jobId != "" {
+		// The v1 client is used to query the EVS job detail.
+		evsV1Client, err := cfg.BlockStorageV1Client(region)
+		if err != nil {
+			return fmt.Errorf("error creating EVS v1 client: %s", err)
+		}
+		if err = waitEvsJobSuccess(ctx, evsV1Client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("the job (%s) is not SUCCESS while changing the type of EVS volume (%s): %s", jobId,
+				d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for the EVS volume to become available or in-use, the volume ID is %s.", d.Id())
+	stateConf = &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      refreshVolumeTypeFunc(client, d.Id(), volumeType),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the type changing of EVS volume (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 	evsV2Client, err := cfg.BlockStorageV2Client(cfg.GetRegion(d))
@@ -598,9 +775,16 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChanges("iops", "throughput") {
-		err := modifyQoS(ctx, evsV2Client, d, *cfg)
-		return diag.FromErr(err)
+	// The retype request carries the new IOPS and throughput, so QoS only needs to be modified separately if the
+	// volume type is not changed.
+	if d.HasChange("volume_type") {
+		if err = modifyVolumeType(ctx, evsV2Client, d, cfg); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChanges("iops", "throughput") {
+		if err = modifyQoS(ctx, evsV2Client, d, *cfg); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if d.HasChange("auto_renew") {
@@ -757,9 +941,24 @@ func refreshVolumeStatusFunc(c *golangsdk.ServiceClient, volumeId string) resour
 			return response, status, fmt.Errorf("unexpect status (%s)", status)
 		}
 
-		if utils.StrSliceContains([]string{"available", "in_use"}, status) {
+		if utils.StrSliceContains([]string{"available", "in-use"}, status) {
 			return response, "COMPLETED", nil
 		}
 		return response, "PENDING", nil
 	}
 }
+
+func refreshVolumeTypeFunc(c *golangsdk.ServiceClient, volumeId, volumeType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		response, status, err := refreshVolumeStatusFunc(c, volumeId)()
+		if err != nil || status != "COMPLETED" {
+			return response, status, err
+		}
+
+		// The volume status is restored before the new type takes effect.
+		if volume, ok := response.(*cloudvolumes.Volume); ok && volume.VolumeType != volumeType {
+			return response, "PENDING", nil
+		}
+		return response, status, nil
+	}
+}
@@ -0,0 +1,676 @@
+package nat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/nat/v2/dnats"
+	"github.com/chnsz/golangsdk/pagination"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The maximum number of DNAT rules which can be created by one batch request.
+const maxDnatRulesPerBatch = 100
+
+// @API NAT GET /v2/{project_id}/dnat_rules
+// @API NAT POST /v2/{project_id}/dnat_rules/batch
+// @API NAT PUT /v2/{project_id}/dnat_rules/{id}
+// @API NAT DELETE /v2/{project_id}/nat_gateways/{nat_gateway_id}/dnat_rules/{id}
+func ResourcePublicDnatRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePublicDnatRulesCreate,
+		ReadContext:   resourcePublicDnatRulesRead,
+		UpdateContext: resourcePublicDnatRulesUpdate,
+		DeleteContext: resourcePublicDnatRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourcePublicDnatRulesCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region where the DNAT rules are located.",
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the NAT gateway to which the DNAT rules belong.",
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"floating_ip_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the floating IP address.",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "any"}, false),
+							Description:  "The protocol type.",
+						},
+						"internal_service_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "The port used by ECSs or BMSs to provide services for external systems.",
+						},
+						"external_service_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "The port used by the floating IP to provide services for external systems.",
+						},
+						"internal_service_port_range": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+-\d+$`), "invalid port range"),
+							Description:  "The port range used by ECSs or BMSs to provide services for external systems.",
+						},
+						"external_service_port_range": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+-\d+$`), "invalid port range"),
+							Description:  "The port range used by the floating IP to provide services for external systems.",
+						},
+						"port_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The port ID of the backend instance.",
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The private IP address of the backend instance.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the DNAT rule.",
+						},
+					},
+				},
+				Description: "The DNAT rules managed by this resource.",
+			},
+		},
+	}
+}
+
+// dnatRuleFootprint is the range of external ports occupied by a DNAT rule on the floating IP.
+type dnatRuleFootprint struct {
+	floatingIpId string
+	protocol     string
+	low          int
+	high         int
+}
+
+func (f dnatRuleFootprint) overlaps(other dnatRuleFootprint) bool {
+	if f.floatingIpId != other.floatingIpId {
+		return false
+	}
+	if f.protocol != other.protocol && f.protocol != "any" && other.protocol != "any" {
+		return false
+	}
+	return f.low <= other.high && other.low <= f.high
+}
+
+func (f dnatRuleFootprint) String() string {
+	if f.low == f.high {
+		return fmt.Sprintf("%s port %d of floating IP (%s)", f.protocol, f.low, f.floatingIpId)
+	}
+	return fmt.Sprintf("%s ports %d-%d of floating IP (%s)", f.protocol, f.low, f.high, f.floatingIpId)
+}
+
+func parsePortRange(portRange string) (low, high int, err error) {
+	parts := strings.Split(portRange, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid port range (%s)", portRange)
+	}
+	if low, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, fmt.Errorf("invalid port range (%s): %s", portRange, err)
+	}
+	if high, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, fmt.Errorf("invalid port range (%s): %s", portRange, err)
+	}
+	if low < 1 || high > 65535 || low > high {
+		return 0, 0, fmt.Errorf("invalid port range (%s), the ports must be in ascending order and between 1 and 65535",
+			portRange)
+	}
+	return low, high, nil
+}
+
+func buildDnatRuleFootprint(floatingIpId, protocol string, externalPort int, externalPortRange string) (dnatRuleFootprint,
+	error) {
+	result := dnatRuleFootprint{
+		floatingIpId: floatingIpId,
+		protocol:     protocol,
+		low:          externalPort,
+		high:         externalPort,
+	}
+	if externalPortRange != "" {
+		low, high, err := parsePortRange(externalPortRange)
+		if err != nil {
+			return result, err
+		}
+		result.low, result.high = low, high
+	} else if protocol == "any" {
+		// The rule of the 'any' protocol forwards all ports of the floating IP.
+		result.low, result.high = 0, 65535
+	}
+	return result, nil
+}
+
+func publicDnatRuleKey(floatingIpId, protocol string, externalPort int, externalPortRange string) string {
+	if externalPortRange != "" {
+		externalPort = 0
+	}
+	return fmt.Sprintf("%s/%s/%d/%s", floatingIpId, protocol, externalPort, externalPortRange)
+}
+
+func publicDnatRuleKeyOf(rule map[string]interface{}) string {
+	return publicDnatRuleKey(rule["floating_ip_id"].(string), rule["protocol"].(string),
+		rule["external_service_port"].(int), rule["external_service_port_range"].(string))
+}
+
+func remotePublicDnatRuleKey(rule interface{}) string {
+	return publicDnatRuleKey(utils.PathSearch("floating_ip_id", rule, "").(string),
+		utils.PathSearch("protocol", rule, "").(string),
+		int(utils.PathSearch("external_service_port", rule, float64(0)).(float64)),
+		utils.PathSearch("external_service_port_range", rule, "").(string))
+}
+
+// listPublicRules returns all DNAT or SNAT rules (depends on the rule type) of the NAT gateway.
+func listPublicRules(client *golangsdk.ServiceClient, ruleType, gatewayId string) ([]interface{}, error) {
+	listPath := client.Endpoint + "v2/{project_id}/{rule_type}?nat_gateway_id={nat_gateway_id}"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{rule_type}", ruleType)
+	listPath = strings.ReplaceAll(listPath, "{nat_gateway_id}", gatewayId)
+
+	listResp, err := pagination.ListAllItems(
+		client,
+		"marker",
+		listPath,
+		&pagination.QueryOpts{MarkerField: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	listRespJson, err := json.Marshal(listResp)
+	if err != nil {
+		return nil, err
+	}
+	var listRespBody interface{}
+	err = json.Unmarshal(listRespJson, &listRespBody)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch(ruleType, listRespBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+func publicRulesStateRefreshFunc(client *golangsdk.ServiceClient, ruleType, gatewayId string, ruleIds,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rules, err := listPublicRules(client, ruleType, gatewayId)
+		if err != nil {
+			return rules, "", err
+		}
+
+		statusMap := make(map[string]string, len(rules))
+		for _, rule := range rules {
+			statusMap[utils.PathSearch("id", rule, "").(string)] = utils.PathSearch("status", rule, "").(string)
+		}
+		for _, ruleId := range ruleIds {
+			status, ok := statusMap[ruleId]
+			if len(targets) < 1 {
+				// Waiting for the rules to be deleted.
+				if ok {
+					return rules, "PENDING", nil
+				}
+				continue
+			}
+
+			if utils.StrSliceContains([]string{"INACTIVE", "EIP_FREEZED"}, status) {
+				return rules, "", fmt.Errorf("unexpect status (%s) of rule (%s)", status, ruleId)
+			}
+			if !utils.StrSliceContains(targets, status) {
+				return rules, "PENDING", nil
+			}
+		}
+		return rules, "COMPLETED", nil
+	}
+}
+
+func waitForPublicRulesCompleted(ctx context.Context, client *golangsdk.ServiceClient, ruleType, gatewayId string,
+	ruleIds, targets []string, timeout time.Duration) error {
+	if len(ruleIds) < 1 {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      publicRulesStateRefreshFunc(client, ruleType, gatewayId, ruleIds, targets),
+		Timeout:      timeout,
+		Delay:        3 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func getPublicDnatRuleFootprints(rules []interface{}) ([]dnatRuleFootprint, error) {
+	result := make([]dnatRuleFootprint, 0, len(rules))
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		floatingIpId := rule["floating_ip_id"].(string)
+		if floatingIpId == "" {
+			// The floating IP is unknown until it is created.
+			continue
+		}
+
+		internalRange, externalRange := rule["internal_service_port_range"].(string),
+			rule["external_service_port_range"].(string)
+		footprint, err := buildDnatRuleFootprint(floatingIpId, rule["protocol"].(string),
+			rule["external_service_port"].(int), externalRange)
+		if err != nil {
+			return nil, err
+		}
+		if internalRange != "" && externalRange != "" {
+			low, high, err := parsePortRange(internalRange)
+			if err != nil {
+				return nil, err
+			}
+			if high-low != footprint.high-footprint.low {
+				return nil, fmt.Errorf("the internal port range (%s) and the external port range (%s) must contain "+
+					"the same number of ports", internalRange, externalRange)
+			}
+		}
+		result = append(result, footprint)
+	}
+	return result, nil
+}
+
+// resourcePublicDnatRulesCustomizeDiff checks whether the external ports of the DNAT rules conflict with each other or
+// with the existing rules of the NAT gateway which are not managed by this resource.
+func resourcePublicDnatRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("rules") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("rules")
+	footprints, err := getPublicDnatRuleFootprints(newRaw.(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(footprints); i++ {
+		for j := i + 1; j < len(footprints); j++ {
+			if footprints[i].overlaps(footprints[j]) {
+				return fmt.Errorf("the DNAT rules conflict with each other: %s overlaps with %s", footprints[i],
+					footprints[j])
+			}
+		}
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	if gatewayId == "" {
+		// The NAT gateway is unknown until it is created, so there are no existing rules.
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return fmt.Errorf("error creating NAT v2 client: %s", err)
+	}
+	existingRules, err := listPublicRules(client, "dnat_rules", gatewayId)
+	if err != nil {
+		return fmt.Errorf("error retrieving DNAT rules of NAT gateway (%s): %s", gatewayId, err)
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range oldRaw.(*schema.Set).List() {
+		managed[publicDnatRuleKeyOf(v.(map[string]interface{}))] = true
+	}
+	for _, rule := range existingRules {
+		if managed[remotePublicDnatRuleKey(rule)] {
+			continue
+		}
+
+		existing, err := buildDnatRuleFootprint(utils.PathSearch("floating_ip_id", rule, "").(string),
+			utils.PathSearch("protocol", rule, "").(string),
+			int(utils.PathSearch("external_service_port", rule, float64(0)).(float64)),
+			utils.PathSearch("external_service_port_range", rule, "").(string))
+		if err != nil {
+			log.Printf("[WARN] unable to parse the external ports of DNAT rule (%s): %s",
+				utils.PathSearch("id", rule, ""), err)
+			continue
+		}
+		for _, footprint := range footprints {
+			if footprint.overlaps(existing) {
+				return fmt.Errorf("%s overlaps with the existing DNAT rule (%s) of NAT gateway (%s)", footprint,
+					utils.PathSearch("id", rule, ""), gatewayId)
+			}
+		}
+	}
+	return nil
+}
+
+func buildPublicDnatRuleBatchCreateParams(gatewayId string, rule map[string]interface{}) map[string]interface{} {
+	return utils.RemoveNil(map[string]interface{}{
+		"nat_gateway_id":              gatewayId,
+		"floating_ip_id":              rule["floating_ip_id"],
+		"protocol":                    rule["protocol"],
+		"internal_service_port":       rule["internal_service_port"],
+		"external_service_port":       rule["external_service_port"],
+		"internal_service_port_range": utils.ValueIngoreEmpty(rule["internal_service_port_range"]),
+		"external_service_port_range": utils.ValueIngoreEmpty(rule["external_service_port_range"]),
+		"port_id":                     utils.ValueIngoreEmpty(rule["port_id"]),
+		"private_ip":                  utils.ValueIngoreEmpty(rule["private_ip"]),
+		"description":                 utils.ValueIngoreEmpty(rule["description"]),
+	})
+}
+
+func buildPublicDnatRuleUpdateOptsFromMap(gatewayId string, rule map[string]interface{}) dnats.UpdateOpts {
+	return dnats.UpdateOpts{
+		GatewayId:                gatewayId,
+		FloatingIpId:             rule["floating_ip_id"].(string),
+		Protocol:                 rule["protocol"].(string),
+		InternalServicePort:      utils.Int(rule["internal_service_port"].(int)),
+		ExternalServicePort:      utils.Int(rule["external_service_port"].(int)),
+		InternalServicePortRange: rule["internal_service_port_range"].(string),
+		ExternalServicePortRange: rule["external_service_port_range"].(string),
+		Description:              utils.String(rule["description"].(string)),
+		PortId:                   rule["port_id"].(string),
+		PrivateIp:                rule["private_ip"].(string),
+	}
+}
+
+// isPublicDnatRuleChanged checks whether the internal side or the description of the existing rule is different from
+// the expected rule.
+func isPublicDnatRuleChanged(current interface{}, expected map[string]interface{}) bool {
+	if utils.PathSearch("description", current, "").(string) != expected["description"].(string) {
+		return true
+	}
+
+	if internalRange := expected["internal_service_port_range"].(string); internalRange != "" {
+		if utils.PathSearch("internal_service_port_range", current, "").(string) != internalRange {
+			return true
+		}
+	} else if int(utils.PathSearch("internal_service_port", current, float64(0)).(float64)) !=
+		expected["internal_service_port"].(int) {
+		return true
+	}
+
+	if portId := expected["port_id"].(string); portId != "" {
+		return utils.PathSearch("port_id", current, "").(string) != portId
+	}
+	return utils.PathSearch("private_ip", current, "").(string) != expected["private_ip"].(string)
+}
+
+func batchCreatePublicDnatRules(client *golangsdk.ServiceClient, gatewayId string,
+	rules []map[string]interface{}) ([]string, error) {
+	createPath := client.Endpoint + "v2/{project_id}/dnat_rules/batch"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+
+	ruleIds := make([]string, 0, len(rules))
+	for start := 0; start < len(rules); start += maxDnatRulesPerBatch {
+		end := start + maxDnatRulesPerBatch
+		if end > len(rules) {
+			end = len(rules)
+		}
+
+		createOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"dnat_rules": rules[start:end],
+			},
+		}
+		createResp, err := client.Request("POST", createPath, &createOpt)
+		if err != nil {
+			return ruleIds, fmt.Errorf("error creating DNAT rules of NAT gateway (%s): %s", gatewayId, err)
+		}
+		createRespBody, err := utils.FlattenResponse(createResp)
+		if err != nil {
+			return ruleIds, err
+		}
+		for _, rule := range utils.PathSearch("dnat_rules", createRespBody, make([]interface{}, 0)).([]interface{}) {
+			ruleIds = append(ruleIds, utils.PathSearch("id", rule, "").(string))
+		}
+	}
+	return ruleIds, nil
+}
+
+func deletePublicDnatRules(ctx context.Context, client *golangsdk.ServiceClient, gatewayId string, ruleIds []string,
+	timeout time.Duration) error {
+	for _, ruleId := range ruleIds {
+		err := dnats.Delete(client, gatewayId, ruleId)
+		if err != nil {
+			return fmt.Errorf("error deleting DNAT rule (%s): %s", ruleId, err)
+		}
+	}
+	return waitForPublicRulesCompleted(ctx, client, "dnat_rules", gatewayId, ruleIds, nil, timeout)
+}
+
+// syncPublicDnatRules creates, updates and deletes the DNAT rules of the NAT gateway to make them match the rules in
+// the configuration. The rules which are not managed by this resource are left untouched.
+func syncPublicDnatRules(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeout time.Duration) error {
+	gatewayId := d.Get("nat_gateway_id").(string)
+	currentRules, err := listPublicRules(client, "dnat_rules", gatewayId)
+	if err != nil {
+		return fmt.Errorf("error retrieving DNAT rules of NAT gateway (%s): %s", gatewayId, err)
+	}
+	currentMap := make(map[string]interface{}, len(currentRules))
+	for _, rule := range currentRules {
+		currentMap[remotePublicDnatRuleKey(rule)] = rule
+	}
+
+	var (
+		oldRaw, newRaw = d.GetChange("rules")
+		createParams   = make([]map[string]interface{}, 0)
+		updatedIds     = make([]string, 0)
+		expected       = make(map[string]bool)
+	)
+	for _, v := range newRaw.(*schema.Set).List() {
+		rule := v.(map[string]interface{})
+		key := publicDnatRuleKeyOf(rule)
+		expected[key] = true
+
+		current, ok := currentMap[key]
+		if !ok {
+			createParams = append(createParams, buildPublicDnatRuleBatchCreateParams(gatewayId, rule))
+			continue
+		}
+		if isPublicDnatRuleChanged(current, rule) {
+			ruleId := utils.PathSearch("id", current, "").(string)
+			_, err = dnats.Update(client, ruleId, buildPublicDnatRuleUpdateOptsFromMap(gatewayId, rule))
+			if err != nil {
+				return fmt.Errorf("error updating DNAT rule (%s): %s", ruleId, err)
+			}
+			updatedIds = append(updatedIds, ruleId)
+		}
+	}
+
+	deletedIds := make([]string, 0)
+	for _, v := range oldRaw.(*schema.Set).List() {
+		key := publicDnatRuleKeyOf(v.(map[string]interface{}))
+		if current, ok := currentMap[key]; ok && !expected[key] {
+			deletedIds = append(deletedIds, utils.PathSearch("id", current, "").(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Syncing DNAT rules of NAT gateway (%s): %d to create, %d to update, %d to delete", gatewayId,
+		len(createParams), len(updatedIds), len(deletedIds))
+	// Delete the rules first to release the external ports which may be used by the new rules.
+	if err = deletePublicDnatRules(ctx, client, gatewayId, deletedIds, timeout); err != nil {
+		return err
+	}
+	createdIds, err := batchCreatePublicDnatRules(client, gatewayId, createParams)
+	if err != nil {
+		return err
+	}
+	return waitForPublicRulesCompleted(ctx, client, "dnat_rules", gatewayId, append(createdIds, updatedIds...),
+		[]string{"ACTIVE"}, timeout)
+}
+
+func resourcePublicDnatRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatGatewayClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	// The ID is set before the rules are synchronized, so the rules which have been created are tracked in the
+	// state even if the synchronization fails.
+	d.SetId(d.Get("nat_gateway_id").(string))
+	if err = syncPublicDnatRules(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePublicDnatRulesRead(ctx, d, meta)
+}
+
+func flattenPublicDnatRules(rules []interface{}, stateRules []interface{}) []map[string]interface{} {
+	stateMap := make(map[string]map[string]interface{}, len(stateRules))
+	for _, v := range stateRules {
+		rule := v.(map[string]interface{})
+		stateMap[publicDnatRuleKeyOf(rule)] = rule
+	}
+
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		key := remotePublicDnatRuleKey(rule)
+		stateRule, ok := stateMap[key]
+		if len(stateMap) > 0 && !ok {
+			// Skip the rules which are not managed by this resource.
+			continue
+		}
+
+		flattened := map[string]interface{}{
+			"floating_ip_id":              utils.PathSearch("floating_ip_id", rule, nil),
+			"protocol":                    utils.PathSearch("protocol", rule, nil),
+			"internal_service_port":       utils.PathSearch("internal_service_port", rule, nil),
+			"external_service_port":       utils.PathSearch("external_service_port", rule, nil),
+			"internal_service_port_range": utils.PathSearch("internal_service_port_range", rule, nil),
+			"external_service_port_range": utils.PathSearch("external_service_port_range", rule, nil),
+			"description":                 utils.PathSearch("description", rule, nil),
+		}
+		// The backend can be specified by either the port ID or the private IP, keep the one used in the configuration.
+		portId := utils.PathSearch("port_id", rule, "").(string)
+		if (ok && stateRule["port_id"].(string) != "") || (!ok && portId != "") {
+			flattened["port_id"] = portId
+		} else {
+			flattened["private_ip"] = utils.PathSearch("private_ip", rule, nil)
+		}
+		result = append(result, flattened)
+	}
+	return result
+}
+
+func resourcePublicDnatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	rules, err := listPublicRules(client, "dnat_rules", d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DNAT rules")
+	}
+	flattened := flattenPublicDnatRules(rules, d.Get("rules").(*schema.Set).List())
+	if len(flattened) < 1 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DNAT rules")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("nat_gateway_id", d.Id()),
+		d.Set("rules", flattened),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving DNAT rules fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePublicDnatRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatGatewayClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	if err = syncPublicDnatRules(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePublicDnatRulesRead(ctx, d, meta)
+}
+
+func resourcePublicDnatRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatGatewayClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	rules, err := listPublicRules(client, "dnat_rules", gatewayId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DNAT rules")
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range d.Get("rules").(*schema.Set).List() {
+		managed[publicDnatRuleKeyOf(v.(map[string]interface{}))] = true
+	}
+	ruleIds := make([]string, 0)
+	for _, rule := range rules {
+		if managed[remotePublicDnatRuleKey(rule)] {
+			ruleIds = append(ruleIds, utils.PathSearch("id", rule, "").(string))
+		}
+	}
+
+	if err = deletePublicDnatRules(ctx, client, gatewayId, ruleIds, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
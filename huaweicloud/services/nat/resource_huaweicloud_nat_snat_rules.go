@@ -0,0 +1,433 @@
+package nat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/nat/v2/snats"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/eips"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API NAT GET /v2/{project_id}/snat_rules
+// @API NAT POST /v2/{project_id}/snat_rules
+// @API NAT PUT /v2/{project_id}/snat_rules/{ruleId}
+// @API NAT DELETE /v2/{project_id}/nat_gateways/{gatewayId}/snat_rules/{ruleId}
+// @API EIP GET /v1/{project_id}/publicips/{id}
+func ResourcePublicSnatRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePublicSnatRulesCreate,
+		ReadContext:   resourcePublicSnatRulesRead,
+		UpdateContext: resourcePublicSnatRulesUpdate,
+		DeleteContext: resourcePublicSnatRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourcePublicSnatRulesCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region where the SNAT rules are located.",
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the NAT gateway to which the SNAT rules belong.",
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"floating_ip_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IDs (separated by commas) of floating IPs connected by SNAT rule.",
+						},
+						"source_type": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ValidateFunc: validation.IntInSlice([]int{
+								int(SourceTypeVpc),
+								int(SourceTypeDc),
+							}),
+							Description: "The resource type of the SNAT rule.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The network ID of subnet connected by SNAT rule (VPC side).",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CIDR block connected by SNAT rule (DC side).",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the SNAT rule.",
+						},
+					},
+				},
+				Description: "The SNAT rules managed by this resource.",
+			},
+		},
+	}
+}
+
+func publicSnatRuleKey(sourceType int, subnetId, cidr string) string {
+	return fmt.Sprintf("%d/%s/%s", sourceType, subnetId, cidr)
+}
+
+func publicSnatRuleKeyOf(rule map[string]interface{}) string {
+	return publicSnatRuleKey(rule["source_type"].(int), rule["subnet_id"].(string), rule["cidr"].(string))
+}
+
+func remotePublicSnatRuleKey(rule interface{}) string {
+	return publicSnatRuleKey(int(utils.PathSearch("source_type", rule, float64(0)).(float64)),
+		utils.PathSearch("network_id", rule, "").(string),
+		utils.PathSearch("cidr", rule, "").(string))
+}
+
+// isSameFloatingIps checks whether the two floating IP lists (separated by commas) contain the same floating IPs.
+func isSameFloatingIps(ids, anotherIds string) bool {
+	idList, anotherIdList := strings.Split(ids, ","), strings.Split(anotherIds, ",")
+	sort.Strings(idList)
+	sort.Strings(anotherIdList)
+	return strings.Join(idList, ",") == strings.Join(anotherIdList, ",")
+}
+
+// resourcePublicSnatRulesCustomizeDiff checks whether there are multiple SNAT rules for the same subnet or CIDR block,
+// including the existing rules of the NAT gateway which are not managed by this resource.
+func resourcePublicSnatRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("rules") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("rules")
+	expected := make(map[string]bool)
+	for _, v := range newRaw.(*schema.Set).List() {
+		rule := v.(map[string]interface{})
+		subnetId, cidr := rule["subnet_id"].(string), rule["cidr"].(string)
+		if rule["source_type"].(int) == int(SourceTypeDc) && subnetId != "" {
+			return fmt.Errorf("in the DC (Direct Connect) scenario (source_type is 1), only the parameter 'cidr' " +
+				"is valid, and the parameter 'subnet_id' must be empty")
+		}
+		if subnetId != "" && cidr != "" {
+			return fmt.Errorf("only one of 'subnet_id' and 'cidr' can be specified in the SNAT rule")
+		}
+		if subnetId == "" && cidr == "" {
+			// The subnet or CIDR block is unknown until it is created.
+			continue
+		}
+
+		key := publicSnatRuleKeyOf(rule)
+		if expected[key] {
+			return fmt.Errorf("the subnet or CIDR block (%s%s) is connected by more than one SNAT rule", subnetId, cidr)
+		}
+		expected[key] = true
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	if gatewayId == "" {
+		// The NAT gateway is unknown until it is created, so there are no existing rules.
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return fmt.Errorf("error creating NAT v2 client: %s", err)
+	}
+	existingRules, err := listPublicRules(client, "snat_rules", gatewayId)
+	if err != nil {
+		return fmt.Errorf("error retrieving SNAT rules of NAT gateway (%s): %s", gatewayId, err)
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range oldRaw.(*schema.Set).List() {
+		managed[publicSnatRuleKeyOf(v.(map[string]interface{}))] = true
+	}
+	for _, rule := range existingRules {
+		key := remotePublicSnatRuleKey(rule)
+		if expected[key] && !managed[key] {
+			return fmt.Errorf("the subnet or CIDR block (%s%s) is already connected by the existing SNAT rule (%s) "+
+				"of NAT gateway (%s)", utils.PathSearch("network_id", rule, ""), utils.PathSearch("cidr", rule, ""),
+				utils.PathSearch("id", rule, ""), gatewayId)
+		}
+	}
+	return nil
+}
+
+// getFloatingIpAddresses returns the floating IP addresses (separated by commas) of the floating IP IDs.
+func getFloatingIpAddresses(eipClient *golangsdk.ServiceClient, eipIds string) (string, error) {
+	eipList := strings.Split(eipIds, ",")
+	eipAddrs := make([]string, len(eipList))
+	for i, eipId := range eipList {
+		eIP, err := eips.Get(eipClient, eipId).Extract()
+		if err != nil {
+			return "", fmt.Errorf("error fetching EIP (%s): %s", eipId, err)
+		}
+		eipAddrs[i] = eIP.PublicAddress
+	}
+	return strings.Join(eipAddrs, ","), nil
+}
+
+func deletePublicSnatRules(ctx context.Context, client *golangsdk.ServiceClient, gatewayId string, ruleIds []string,
+	timeout time.Duration) error {
+	for _, ruleId := range ruleIds {
+		err := snats.Delete(client, gatewayId, ruleId)
+		if err != nil {
+			return fmt.Errorf("error deleting public SNAT rule (%s): %s", ruleId, err)
+		}
+	}
+	return waitForPublicRulesCompleted(ctx, client, "snat_rules", gatewayId, ruleIds, nil, timeout)
+}
+
+// syncPublicSnatRules creates, updates and deletes the SNAT rules of the NAT gateway to make them match the rules in
+// the configuration. All requests are sent before waiting, so the rules are provisioned concurrently.
+func syncPublicSnatRules(ctx context.Context, cfg *config.Config, client *golangsdk.ServiceClient,
+	d *schema.ResourceData, timeout time.Duration) error {
+	gatewayId := d.Get("nat_gateway_id").(string)
+	currentRules, err := listPublicRules(client, "snat_rules", gatewayId)
+	if err != nil {
+		return fmt.Errorf("error retrieving SNAT rules of NAT gateway (%s): %s", gatewayId, err)
+	}
+	currentMap := make(map[string]interface{}, len(currentRules))
+	for _, rule := range currentRules {
+		currentMap[remotePublicSnatRuleKey(rule)] = rule
+	}
+
+	var (
+		oldRaw, newRaw = d.GetChange("rules")
+		createOpts     = make([]snats.CreateOpts, 0)
+		updateOpts     = make(map[string]snats.UpdateOpts)
+		expected       = make(map[string]bool)
+	)
+	for _, v := range newRaw.(*schema.Set).List() {
+		rule := v.(map[string]interface{})
+		key := publicSnatRuleKeyOf(rule)
+		expected[key] = true
+
+		current, ok := currentMap[key]
+		if !ok {
+			createOpts = append(createOpts, snats.CreateOpts{
+				GatewayId:    gatewayId,
+				FloatingIpId: rule["floating_ip_id"].(string),
+				NetworkId:    rule["subnet_id"].(string),
+				Cidr:         rule["cidr"].(string),
+				SourceType:   rule["source_type"].(int),
+				Description:  rule["description"].(string),
+			})
+			continue
+		}
+
+		var (
+			ruleId   = utils.PathSearch("id", current, "").(string)
+			sameEips = isSameFloatingIps(utils.PathSearch("floating_ip_id", current, "").(string),
+				rule["floating_ip_id"].(string))
+			sameDesc = utils.PathSearch("description", current, "").(string) == rule["description"].(string)
+		)
+		if sameEips && sameDesc {
+			continue
+		}
+		opts := snats.UpdateOpts{
+			GatewayId:   gatewayId,
+			Description: utils.String(rule["description"].(string)),
+		}
+		if !sameEips {
+			eipClient, err := cfg.NetworkingV1Client(cfg.GetRegion(d))
+			if err != nil {
+				return fmt.Errorf("error creating VPC v1 client: %s", err)
+			}
+			opts.FloatingIpAddress, err = getFloatingIpAddresses(eipClient, rule["floating_ip_id"].(string))
+			if err != nil {
+				return err
+			}
+		}
+		updateOpts[ruleId] = opts
+	}
+
+	deletedIds := make([]string, 0)
+	for _, v := range oldRaw.(*schema.Set).List() {
+		key := publicSnatRuleKeyOf(v.(map[string]interface{}))
+		if current, ok := currentMap[key]; ok && !expected[key] {
+			deletedIds = append(deletedIds, utils.PathSearch("id", current, "").(string))
+		}
+	}
+
+	log.Printf("[DEBUG] Syncing SNAT rules of NAT gateway (%s): %d to create, %d to update, %d to delete", gatewayId,
+		len(createOpts), len(updateOpts), len(deletedIds))
+	// Delete the rules first to release the subnets which may be connected by the new rules.
+	if err = deletePublicSnatRules(ctx, client, gatewayId, deletedIds, timeout); err != nil {
+		return err
+	}
+
+	ruleIds := make([]string, 0, len(createOpts)+len(updateOpts))
+	for _, opts := range createOpts {
+		resp, err := snats.Create(client, opts)
+		if err != nil {
+			return fmt.Errorf("error creating public SNAT rule: %s", err)
+		}
+		ruleIds = append(ruleIds, resp.ID)
+	}
+	for ruleId, opts := range updateOpts {
+		_, err = snats.Update(client, ruleId, opts)
+		if err != nil {
+			return fmt.Errorf("error updating public SNAT rule (%s): %s", ruleId, err)
+		}
+		ruleIds = append(ruleIds, ruleId)
+	}
+	return waitForPublicRulesCompleted(ctx, client, "snat_rules", gatewayId, ruleIds, []string{"ACTIVE"}, timeout)
+}
+
+func resourcePublicSnatRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatGatewayClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	// The ID is set before the rules are synchronized, so the rules which have been created are tracked in the
+	// state even if the synchronization fails.
+	d.SetId(d.Get("nat_gateway_id").(string))
+	if err = syncPublicSnatRules(ctx, cfg, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePublicSnatRulesRead(ctx, d, meta)
+}
+
+func flattenPublicSnatRules(rules []interface{}, stateRules []interface{}) []map[string]interface{} {
+	stateMap := make(map[string]map[string]interface{}, len(stateRules))
+	for _, v := range stateRules {
+		rule := v.(map[string]interface{})
+		stateMap[publicSnatRuleKeyOf(rule)] = rule
+	}
+
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		stateRule, ok := stateMap[remotePublicSnatRuleKey(rule)]
+		if len(stateMap) > 0 && !ok {
+			// Skip the rules which are not managed by this resource.
+			continue
+		}
+
+		// Keep the order of the floating IPs in the configuration.
+		floatingIpId := utils.PathSearch("floating_ip_id", rule, "").(string)
+		if ok && isSameFloatingIps(floatingIpId, stateRule["floating_ip_id"].(string)) {
+			floatingIpId = stateRule["floating_ip_id"].(string)
+		}
+		result = append(result, map[string]interface{}{
+			"floating_ip_id": floatingIpId,
+			"source_type":    utils.PathSearch("source_type", rule, nil),
+			"subnet_id":      utils.PathSearch("network_id", rule, nil),
+			"cidr":           utils.PathSearch("cidr", rule, nil),
+			"description":    utils.PathSearch("description", rule, nil),
+		})
+	}
+	return result
+}
+
+func resourcePublicSnatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatGatewayClient(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	rules, err := listPublicRules(client, "snat_rules", d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SNAT rules")
+	}
+	flattened := flattenPublicSnatRules(rules, d.Get("rules").(*schema.Set).List())
+	if len(flattened) < 1 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving SNAT rules")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("nat_gateway_id", d.Id()),
+		d.Set("rules", flattened),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving SNAT rules fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePublicSnatRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatGatewayClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	if err = syncPublicSnatRules(ctx, cfg, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePublicSnatRulesRead(ctx, d, meta)
+}
+
+func resourcePublicSnatRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatGatewayClient(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	rules, err := listPublicRules(client, "snat_rules", gatewayId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SNAT rules")
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range d.Get("rules").(*schema.Set).List() {
+		managed[publicSnatRuleKeyOf(v.(map[string]interface{}))] = true
+	}
+	ruleIds := make([]string, 0)
+	for _, rule := range rules {
+		if managed[remotePublicSnatRuleKey(rule)] {
+			ruleIds = append(ruleIds, utils.PathSearch("id", rule, "").(string))
+		}
+	}
+
+	if err = deletePublicSnatRules(ctx, client, gatewayId, ruleIds, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,689 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/routetables"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/subnets"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	v3groups "github.com/chnsz/golangsdk/openstack/networking/v3/security/groups"
+	v3rules "github.com/chnsz/golangsdk/openstack/networking/v3/security/rules"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	reachabilityVerdictAllow = "allow"
+	reachabilityVerdictDeny  = "deny"
+	reachabilityVerdictSkip  = "skip"
+)
+
+var reachabilityProtocolNumbers = map[string]string{
+	"icmp":   "1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmpv6": "58",
+}
+
+// @API VPC GET /v2.0/ports/{port_id}
+// @API VPC GET /v1/{project_id}/subnets/{subnet_id}
+// @API VPC GET /v1/{project_id}/subnets
+// @API VPC GET /v1/{project_id}/routetables
+// @API VPC GET /v1/{project_id}/routetables/{id}
+// @API VPC GET /v3/{project_id}/vpc/security-groups/{secgroupId}
+// @API VPC GET /v3/{project_id}/vpc/firewalls
+// @API VPC GET /v3/{project_id}/vpc/firewalls/{id}
+// @API VPC GET /v3/{project_id}/vpc/address-groups/{address_group_id}
+func DataSourceVpcReachabilityAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcReachabilityAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_port_id", "source_ip"},
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateIP,
+			},
+			"destination_port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"destination_port_id", "destination_ip"},
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateIP,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "icmp", "icmpv6",
+				}, false),
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"reachable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hops": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"verdict": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// reachabilityEndpoint is the source or destination of the analysis, only the IP address is known if it is not a port.
+type reachabilityEndpoint struct {
+	ip               net.IP
+	portId           string
+	subnetId         string
+	vpcId            string
+	securityGroupIds []string
+}
+
+type reachabilityAnalyzer struct {
+	v1Client *golangsdk.ServiceClient
+	v2Client *golangsdk.ServiceClient
+	v3Client *golangsdk.ServiceClient
+
+	protocol string
+	port     int
+
+	// The IP sets of the address groups, which are cached to avoid querying the same address group repeatedly.
+	addressGroups map[string][]string
+}
+
+func newReachabilityHop(hopType, resourceId, verdict, matchedRule, description string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":         hopType,
+		"resource_id":  resourceId,
+		"verdict":      verdict,
+		"matched_rule": matchedRule,
+		"description":  description,
+	}
+}
+
+func (a *reachabilityAnalyzer) resolveEndpoint(portId, ipAddress string) (*reachabilityEndpoint, error) {
+	if portId == "" {
+		return &reachabilityEndpoint{ip: net.ParseIP(ipAddress)}, nil
+	}
+
+	port, err := ports.Get(a.v2Client, portId).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving port (%s): %s", portId, err)
+	}
+	if len(port.FixedIPs) < 1 {
+		return nil, fmt.Errorf("the port (%s) has no fixed IP address", portId)
+	}
+	// The network ID of the port is the ID of the VPC subnet.
+	subnet, err := subnets.Get(a.v1Client, port.NetworkID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving subnet (%s) of port (%s): %s", port.NetworkID, portId, err)
+	}
+
+	return &reachabilityEndpoint{
+		ip:               net.ParseIP(port.FixedIPs[0].IPAddress),
+		portId:           portId,
+		subnetId:         subnet.ID,
+		vpcId:            subnet.VPC_ID,
+		securityGroupIds: port.SecurityGroups,
+	}, nil
+}
+
+func (a *reachabilityAnalyzer) getAddressGroupIpSet(addressGroupId string) ([]string, error) {
+	if ipSet, ok := a.addressGroups[addressGroupId]; ok {
+		return ipSet, nil
+	}
+
+	getPath := a.v3Client.ResourceBaseURL() + "vpc/address-groups/" + addressGroupId
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := a.v3Client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving address group (%s): %s", addressGroupId, err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+
+	ipSet := utils.ExpandToStringList(utils.PathSearch("address_group.ip_set", getRespBody,
+		make([]interface{}, 0)).([]interface{}))
+	a.addressGroups[addressGroupId] = ipSet
+	return ipSet, nil
+}
+
+// matchReachabilityAddress checks whether the IP address is contained by the address entry, which can be a single IP
+// address, a CIDR or an IP address range (such as 192.168.0.1-192.168.0.100). An empty entry matches all addresses.
+func matchReachabilityAddress(entry string, ip net.IP) bool {
+	entry = strings.TrimSpace(entry)
+	if entry == "" {
+		return true
+	}
+	if strings.Contains(entry, "/") {
+		_, ipNet, err := net.ParseCIDR(entry)
+		return err == nil && ipNet.Contains(ip)
+	}
+	if strings.Contains(entry, "-") {
+		bounds := strings.SplitN(entry, "-", 2)
+		start, end := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+		if start == nil || end == nil {
+			return false
+		}
+		return compareIPs(ip, start) >= 0 && compareIPs(ip, end) <= 0
+	}
+	return ip.Equal(net.ParseIP(entry))
+}
+
+func compareIPs(a, b net.IP) int {
+	return strings.Compare(string(a.To16()), string(b.To16()))
+}
+
+func (a *reachabilityAnalyzer) matchAddressGroup(addressGroupId string, ip net.IP) (bool, error) {
+	ipSet, err := a.getAddressGroupIpSet(addressGroupId)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range ipSet {
+		if entry != "" && matchReachabilityAddress(entry, ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchReachabilityProtocol checks whether the rule protocol covers the analyzed protocol, the rule protocol can be a
+// protocol name or number. An empty value or "any" means all protocols.
+func matchReachabilityProtocol(ruleProtocol, protocol string) bool {
+	ruleProtocol = strings.ToLower(ruleProtocol)
+	if ruleProtocol == "" || ruleProtocol == "any" {
+		return true
+	}
+	return ruleProtocol == protocol || ruleProtocol == reachabilityProtocolNumbers[protocol]
+}
+
+// matchReachabilityPort checks whether the port ranges (such as 22,80-90) cover the port. An empty value means all
+// ports, and if the port is not specified (zero), only the ranges which cover all ports are matched.
+func matchReachabilityPort(rulePorts string, port int) bool {
+	rulePorts = strings.ReplaceAll(rulePorts, " ", "")
+	if rulePorts == "" {
+		return true
+	}
+	for _, portRange := range strings.Split(rulePorts, ",") {
+		bounds := strings.SplitN(portRange, "-", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			continue
+		}
+		end := start
+		if len(bounds) > 1 {
+			if end, err = strconv.Atoi(bounds[1]); err != nil {
+				continue
+			}
+		}
+		if port == 0 && start <= 1 && end >= 65535 {
+			return true
+		}
+		if port != 0 && start <= port && port <= end {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *reachabilityAnalyzer) matchPort(rulePorts string) bool {
+	// The ICMP packets have no ports.
+	if a.protocol == "icmp" || a.protocol == "icmpv6" {
+		return true
+	}
+	return matchReachabilityPort(rulePorts, a.port)
+}
+
+func getReachabilityEthertype(ip net.IP) string {
+	if ip.To4() != nil {
+		return "IPv4"
+	}
+	return "IPv6"
+}
+
+func (a *reachabilityAnalyzer) matchSecurityGroupRule(rule v3rules.SecurityGroupRule, direction string,
+	peer *reachabilityEndpoint) (bool, error) {
+	if rule.Direction != direction || !strings.EqualFold(rule.Ethertype, getReachabilityEthertype(peer.ip)) {
+		return false, nil
+	}
+	if !matchReachabilityProtocol(rule.Protocol, a.protocol) || !a.matchPort(rule.MultiPort) {
+		return false, nil
+	}
+
+	switch {
+	case rule.RemoteGroupId != "":
+		return utils.StrSliceContains(peer.securityGroupIds, rule.RemoteGroupId), nil
+	case rule.RemoteAddressGroupId != "":
+		return a.matchAddressGroup(rule.RemoteAddressGroupId, peer.ip)
+	default:
+		return matchReachabilityAddress(rule.RemoteIpPrefix, peer.ip), nil
+	}
+}
+
+// evaluateSecurityGroups aggregates the rules of all security groups bound to the port and matches them by priority,
+// the deny rules take precedence over the allow rules with the same priority.
+func (a *reachabilityAnalyzer) evaluateSecurityGroups(hopType, direction string, endpoint,
+	peer *reachabilityEndpoint) (map[string]interface{}, error) {
+	resourceId := strings.Join(endpoint.securityGroupIds, ",")
+	if endpoint.portId == "" {
+		return newReachabilityHop(hopType, resourceId, reachabilityVerdictSkip, "",
+			"the endpoint is not a port, the security groups are not evaluated"), nil
+	}
+
+	matchedRules := make([]v3rules.SecurityGroupRule, 0)
+	for _, groupId := range endpoint.securityGroupIds {
+		securityGroup, err := v3groups.Get(a.v3Client, groupId)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving security group (%s): %s", groupId, err)
+		}
+		for _, rule := range securityGroup.SecurityGroupRules {
+			matched, err := a.matchSecurityGroupRule(rule, direction, peer)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				matchedRules = append(matchedRules, rule)
+			}
+		}
+	}
+
+	if len(matchedRules) < 1 {
+		return newReachabilityHop(hopType, resourceId, reachabilityVerdictDeny, "",
+			fmt.Sprintf("no %s rule of the security groups matches the traffic", direction)), nil
+	}
+	sort.SliceStable(matchedRules, func(i, j int) bool {
+		if matchedRules[i].Priority != matchedRules[j].Priority {
+			return matchedRules[i].Priority < matchedRules[j].Priority
+		}
+		return matchedRules[i].Action == reachabilityVerdictDeny && matchedRules[j].Action != reachabilityVerdictDeny
+	})
+
+	rule := matchedRules[0]
+	verdict := reachabilityVerdictAllow
+	if rule.Action == reachabilityVerdictDeny {
+		verdict = reachabilityVerdictDeny
+	}
+	return newReachabilityHop(hopType, rule.SecurityGroupId, verdict, rule.ID,
+		fmt.Sprintf("the %s rule (priority %d) of the security group is matched", direction, rule.Priority)), nil
+}
+
+func (a *reachabilityAnalyzer) getSubnetNetworkAcl(subnetId string) (interface{}, error) {
+	listPath := a.v3Client.ResourceBaseURL() + "vpc/firewalls?limit=2000"
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+
+	var firewallId string
+	marker := ""
+	for firewallId == "" {
+		listPathWithMarker := listPath
+		if marker != "" {
+			listPathWithMarker += "&marker=" + marker
+		}
+		listResp, err := a.v3Client.Request("GET", listPathWithMarker, &listOpt)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving network ACLs: %s", err)
+		}
+		listRespBody, err := utils.FlattenResponse(listResp)
+		if err != nil {
+			return nil, err
+		}
+
+		expression := fmt.Sprintf("firewalls[?associations[?virsubnet_id=='%s']]|[0].id", subnetId)
+		firewallId = utils.PathSearch(expression, listRespBody, "").(string)
+		marker = utils.PathSearch("page_info.next_marker", listRespBody, "").(string)
+		if marker == "" {
+			break
+		}
+	}
+	if firewallId == "" {
+		return nil, nil
+	}
+
+	getPath := a.v3Client.ResourceBaseURL() + "vpc/firewalls/" + firewallId
+	getResp, err := a.v3Client.Request("GET", getPath, &listOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving network ACL (%s): %s", firewallId, err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("firewall", getRespBody, nil), nil
+}
+
+func (a *reachabilityAnalyzer) matchNetworkAclRule(rule interface{}, source, destination net.IP) (bool, error) {
+	if !utils.PathSearch("enabled", rule, true).(bool) {
+		return false, nil
+	}
+	ipVersion := fmt.Sprint(utils.PathSearch("ip_version", rule, float64(4)))
+	if (ipVersion == "4") != (source.To4() != nil) {
+		return false, nil
+	}
+	if !matchReachabilityProtocol(utils.PathSearch("protocol", rule, "").(string), a.protocol) {
+		return false, nil
+	}
+	// The source port of the traffic is unknown, so only the rules which cover all source ports are matched.
+	if !a.matchPort(utils.PathSearch("destination_port", rule, "").(string)) ||
+		!matchReachabilityPort(utils.PathSearch("source_port", rule, "").(string), 0) {
+		return false, nil
+	}
+
+	addresses := []struct {
+		ip             net.IP
+		address        string
+		addressGroupId string
+	}{
+		{source, utils.PathSearch("source_ip_address", rule, "").(string),
+			utils.PathSearch("source_ip_address_group_id", rule, "").(string)},
+		{destination, utils.PathSearch("destination_ip_address", rule, "").(string),
+			utils.PathSearch("destination_ip_address_group_id", rule, "").(string)},
+	}
+	for _, addr := range addresses {
+		if addr.addressGroupId != "" {
+			matched, err := a.matchAddressGroup(addr.addressGroupId, addr.ip)
+			if err != nil || !matched {
+				return false, err
+			}
+			continue
+		}
+		if !matchReachabilityAddress(addr.address, addr.ip) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// evaluateNetworkAcl matches the rules of the network ACL associated with the subnet in order, the traffic is denied
+// if no rule is matched.
+func (a *reachabilityAnalyzer) evaluateNetworkAcl(hopType, direction string, endpoint *reachabilityEndpoint,
+	source, destination net.IP, sameSubnet bool) (map[string]interface{}, error) {
+	if endpoint.portId == "" {
+		return newReachabilityHop(hopType, "", reachabilityVerdictSkip, "",
+			"the endpoint is not a port, the network ACL is not evaluated"), nil
+	}
+	if sameSubnet {
+		return newReachabilityHop(hopType, "", reachabilityVerdictSkip, "",
+			"the traffic within the same subnet is not controlled by the network ACL"), nil
+	}
+
+	networkAcl, err := a.getSubnetNetworkAcl(endpoint.subnetId)
+	if err != nil {
+		return nil, err
+	}
+	if networkAcl == nil {
+		return newReachabilityHop(hopType, "", reachabilityVerdictAllow, "",
+			fmt.Sprintf("no network ACL is associated with the subnet (%s)", endpoint.subnetId)), nil
+	}
+
+	aclId := utils.PathSearch("id", networkAcl, "").(string)
+	if !utils.PathSearch("admin_state_up", networkAcl, true).(bool) {
+		return newReachabilityHop(hopType, aclId, reachabilityVerdictAllow, "", "the network ACL is disabled"), nil
+	}
+
+	rules := utils.PathSearch(direction+"_rules", networkAcl, make([]interface{}, 0)).([]interface{})
+	for _, rule := range rules {
+		matched, err := a.matchNetworkAclRule(rule, source, destination)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+
+		verdict := reachabilityVerdictAllow
+		if utils.PathSearch("action", rule, "").(string) == reachabilityVerdictDeny {
+			verdict = reachabilityVerdictDeny
+		}
+		return newReachabilityHop(hopType, aclId, verdict, utils.PathSearch("id", rule, "").(string),
+			fmt.Sprintf("the %s rule (%v) of the network ACL is matched", direction,
+				utils.PathSearch("name", rule, ""))), nil
+	}
+	return newReachabilityHop(hopType, aclId, reachabilityVerdictDeny, "",
+		fmt.Sprintf("no %s rule of the network ACL matches the traffic", direction)), nil
+}
+
+// evaluateRoute looks up the route of the destination in the route table associated with the source subnet. The
+// next hops out of the VPC (such as peering connections and enterprise routers) are reported without being followed.
+func (a *reachabilityAnalyzer) evaluateRoute(source, destination *reachabilityEndpoint) (map[string]interface{},
+	error) {
+	hopType := "route"
+	if source.portId == "" {
+		return newReachabilityHop(hopType, "", reachabilityVerdictSkip, "",
+			"the source is not a port, the route is not evaluated"), nil
+	}
+	if destination.vpcId == source.vpcId {
+		return newReachabilityHop(hopType, source.vpcId, reachabilityVerdictAllow, "local",
+			"the destination is in the same VPC"), nil
+	}
+
+	vpcSubnets, err := subnets.List(a.v1Client, subnets.ListOpts{VPC_ID: source.vpcId})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving subnets of VPC (%s): %s", source.vpcId, err)
+	}
+	for _, subnet := range vpcSubnets {
+		if matchReachabilityAddress(subnet.CIDR, destination.ip) ||
+			(subnet.IPv6CIDR != "" && matchReachabilityAddress(subnet.IPv6CIDR, destination.ip)) {
+			return newReachabilityHop(hopType, source.vpcId, reachabilityVerdictAllow, "local",
+				fmt.Sprintf("the destination is in the subnet (%s) of the same VPC", subnet.ID)), nil
+		}
+	}
+
+	pages, err := routetables.List(a.v1Client, routetables.ListOpts{VpcID: source.vpcId}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving route tables of VPC (%s): %s", source.vpcId, err)
+	}
+	allRouteTables, err := routetables.ExtractRouteTables(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var routeTableId string
+	for _, rtb := range allRouteTables {
+		// The subnets which are not associated with any custom route table use the default route table.
+		if rtb.Default && routeTableId == "" {
+			routeTableId = rtb.ID
+		}
+		for _, subnet := range rtb.Subnets {
+			if subnet.ID == source.subnetId {
+				routeTableId = rtb.ID
+			}
+		}
+	}
+	if routeTableId == "" {
+		return newReachabilityHop(hopType, "", reachabilityVerdictDeny, "",
+			fmt.Sprintf("no route table is found for the subnet (%s)", source.subnetId)), nil
+	}
+
+	routeTable, err := routetables.Get(a.v1Client, routeTableId).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving route table (%s): %s", routeTableId, err)
+	}
+	var matchedRoute *routetables.Route
+	matchedPrefix := -1
+	for i, route := range routeTable.Routes {
+		_, ipNet, err := net.ParseCIDR(route.DestinationCIDR)
+		if err != nil || !ipNet.Contains(destination.ip) {
+			continue
+		}
+		// The longest prefix is matched.
+		if prefix, _ := ipNet.Mask.Size(); prefix > matchedPrefix {
+			matchedPrefix = prefix
+			matchedRoute = &routeTable.Routes[i]
+		}
+	}
+	if matchedRoute == nil {
+		return newReachabilityHop(hopType, routeTableId, reachabilityVerdictDeny, "",
+			"no route of the route table matches the destination"), nil
+	}
+	return newReachabilityHop(hopType, routeTableId, reachabilityVerdictAllow, matchedRoute.DestinationCIDR,
+		fmt.Sprintf("the traffic is forwarded to the next hop (%s) of type %s", matchedRoute.NextHop,
+			matchedRoute.Type)), nil
+}
+
+func (a *reachabilityAnalyzer) analyze(source, destination *reachabilityEndpoint) ([]map[string]interface{}, error) {
+	if (source.ip.To4() != nil) != (destination.ip.To4() != nil) {
+		return nil, fmt.Errorf("the source (%s) and destination (%s) must be in the same IP version",
+			source.ip, destination.ip)
+	}
+
+	sameSubnet := source.subnetId != "" && source.subnetId == destination.subnetId
+	hops := make([]map[string]interface{}, 0, 5)
+	hop, err := a.evaluateSecurityGroups("source_security_group", "egress", source, destination)
+	if err != nil {
+		return nil, err
+	}
+	hops = append(hops, hop)
+
+	hop, err = a.evaluateNetworkAcl("source_network_acl", "egress", source, source.ip, destination.ip, sameSubnet)
+	if err != nil {
+		return nil, err
+	}
+	hops = append(hops, hop)
+
+	hop, err = a.evaluateRoute(source, destination)
+	if err != nil {
+		return nil, err
+	}
+	hops = append(hops, hop)
+
+	hop, err = a.evaluateNetworkAcl("destination_network_acl", "ingress", destination, source.ip, destination.ip,
+		sameSubnet)
+	if err != nil {
+		return nil, err
+	}
+	hops = append(hops, hop)
+
+	hop, err = a.evaluateSecurityGroups("destination_security_group", "ingress", destination, source)
+	if err != nil {
+		return nil, err
+	}
+	return append(hops, hop), nil
+}
+
+func dataSourceVpcReachabilityAnalysisRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	v1Client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking v1 client: %s", err)
+	}
+	v2Client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking v2 client: %s", err)
+	}
+	v3Client, err := cfg.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking v3 client: %s", err)
+	}
+
+	analyzer := &reachabilityAnalyzer{
+		v1Client:      v1Client,
+		v2Client:      v2Client,
+		v3Client:      v3Client,
+		protocol:      d.Get("protocol").(string),
+		port:          d.Get("destination_port").(int),
+		addressGroups: make(map[string][]string),
+	}
+	source, err := analyzer.resolveEndpoint(d.Get("source_port_id").(string), d.Get("source_ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	destination, err := analyzer.resolveEndpoint(d.Get("destination_port_id").(string),
+		d.Get("destination_ip").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hops, err := analyzer.analyze(source, destination)
+	if err != nil {
+		return diag.Errorf("error analyzing the reachability: %s", err)
+	}
+	log.Printf("[DEBUG] The reachability analysis result from %s to %s: %v", source.ip, destination.ip, hops)
+
+	// The traffic is reachable only if at least one hop is evaluated and no hop denies it.
+	var evaluated, denied bool
+	for _, hop := range hops {
+		switch hop["verdict"] {
+		case reachabilityVerdictAllow:
+			evaluated = true
+		case reachabilityVerdictDeny:
+			denied = true
+		}
+	}
+	reachable := evaluated && !denied
+
+	randUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(randUUID)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("reachable", reachable),
+		d.Set("hops", hops),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
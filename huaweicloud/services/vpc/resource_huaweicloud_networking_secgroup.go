@@ -93,6 +93,7 @@ var securityGroupRuleSchema = &schema.Schema{
 // @API VPC GET /v3/{project_id}/vpc/security-groups/{secgroupId}
 // @API VPC PUT /v3/{project_id}/vpc/security-groups/{secgroupId}
 // @API VPC POST /v3/{project_id}/vpc/security-groups
+// @API VPC POST /v3/{project_id}/vpc/security-groups/{secgroupId}/security-group-rules/batch-create
 // @API VPC DELETE /v1/{project_id}/security-group-rules/{ruleId}
 // @API VPC DELETE /v1/{project_id}/security-groups/{securityGroupId}
 // @API VPC GET /v1/{project_id}/security-groups/{securityGroupId}
@@ -137,6 +138,13 @@ func ResourceNetworkingSecGroup() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"managed_rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     securityGroupRuleResource,
+				Set:      hashSecurityGroupRule,
+			},
 			"rules": securityGroupRuleSchema,
 			"created_at": {
 				Type:     schema.TypeString,
@@ -196,12 +204,33 @@ func resourceNetworkingSecGroupCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	// The rules are managed authoritatively only if they are specified.
+	if rules, ok := d.GetOk("managed_rules"); ok {
+		remoteRules := make([]map[string]interface{}, 0)
+		if !deleteDefaultRules {
+			remoteRules, err = flattenSecurityGroupRulesV3(securityGroup.SecurityGroupRules)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		err = updateSecurityGroupRules(v3Client, d.Id(), newSecurityGroupRuleSet(remoteRules), rules.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetworkingSecGroupRead(ctx, d, meta)
 }
 
 func resourceNetworkingSecGroupCreateV1(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 	region := cfg.GetRegion(d)
+	// The inline rules are managed by the batch APIs, which are only provided in ver.3 API.
+	if _, ok := d.GetOk("managed_rules"); ok {
+		return diag.Errorf("the 'managed_rules' parameter is not supported in current region (%s), please use the "+
+			"huaweicloud_networking_secgroup_rule resource instead", region)
+	}
+
 	// The v3 API does not exist or has not been published in this region, retry creation using v1 client.
 	v1Client, err := cfg.NetworkingV1Client(region)
 	if err != nil {
@@ -276,7 +305,8 @@ func resourceNetworkingSecGroupRead(_ context.Context, d *schema.ResourceData, m
 		}
 
 		mErr = multierror.Append(mErr,
-			d.Set("rules", rules), // Override the configuration of the rules list.
+			d.Set("rules", rules),
+			d.Set("managed_rules", rules),
 			d.Set("created_at", v3Resp.CreatedAt),
 			d.Set("updated_at", v3Resp.UpdatedAt),
 		)
@@ -357,24 +387,34 @@ func resourceNetworkingSecGroupUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("error creating networking v3 client: %s", err)
 	}
 
-	description := d.Get("description").(string)
-	name := d.Get("name").(string)
-	updateOpts := v3groups.UpdateOpts{
-		Name:        name,
-		Description: &description,
-	}
+	if d.HasChanges("name", "description") {
+		description := d.Get("description").(string)
+		name := d.Get("name").(string)
+		updateOpts := v3groups.UpdateOpts{
+			Name:        name,
+			Description: &description,
+		}
 
-	log.Printf("[DEBUG] Updating SecGroup %s with options: %#v", d.Id(), updateOpts)
-	_, err = v3groups.Update(client, d.Id(), updateOpts)
-	if err != nil {
-		if _, ok := err.(golangsdk.ErrDefault404); ok {
-			// The v1 API does not support creating and updating description parameters.
-			err = resourceNetworkingSecGroupUpdateV2(d, cfg, region)
-			if err != nil {
-				return diag.Errorf("error updating description of security group (%s): %s", d.Id(), err)
+		log.Printf("[DEBUG] Updating SecGroup %s with options: %#v", d.Id(), updateOpts)
+		_, err = v3groups.Update(client, d.Id(), updateOpts)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				// The v1 API does not support creating and updating description parameters.
+				err = resourceNetworkingSecGroupUpdateV2(d, cfg, region)
+				if err != nil {
+					return diag.Errorf("error updating description of security group (%s): %s", d.Id(), err)
+				}
+			} else {
+				return diag.Errorf("error updating security group (%s): %s", d.Id(), err)
 			}
-		} else {
-			return diag.Errorf("error updating security group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("managed_rules") {
+		oldRaw, newRaw := d.GetChange("managed_rules")
+		err = updateSecurityGroupRules(client, d.Id(), oldRaw.(*schema.Set), newRaw.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -0,0 +1,316 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	v3groups "github.com/chnsz/golangsdk/openstack/networking/v3/security/groups"
+	v3rules "github.com/chnsz/golangsdk/openstack/networking/v3/security/rules"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// securityGroupRuleResource is the element of the rule sets that are managed authoritatively, only the configurable
+// parameters take part in the hash calculation, so the computed ID never causes a diff.
+var securityGroupRuleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"direction": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+		},
+		"ethertype": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "IPv4",
+			ValidateFunc: validation.StringInSlice([]string{"IPv4", "IPv6"}, false),
+		},
+		"protocol": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validation.StringInSlice([]string{"tcp", "udp", "icmp", "icmpv6"}, false),
+				validation.StringMatch(regexp.MustCompile("^([0-1]?[0-9]?[0-9]|2[0-4][0-9]|25[0-5])$"),
+					"The valid protocol is range from 0 to 255.",
+				),
+			),
+		},
+		"ports": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"remote_ip_prefix": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.ValidateCIDR,
+		},
+		"remote_group_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"remote_address_group_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "allow",
+			ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+		},
+		"priority": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"port_range_min": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "schema: Deprecated",
+		},
+		"port_range_max": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "schema: Deprecated",
+		},
+	},
+}
+
+// @API VPC GET /v3/{project_id}/vpc/security-groups/{secgroupId}
+// @API VPC POST /v3/{project_id}/vpc/security-groups/{secgroupId}/security-group-rules/batch-create
+// @API VPC DELETE /v3/{project_id}/vpc/security-group-rules/{ruleId}
+func ResourceNetworkingSecGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSecGroupRulesCreate,
+		ReadContext:   resourceNetworkingSecGroupRulesRead,
+		UpdateContext: resourceNetworkingSecGroupRulesUpdate,
+		DeleteContext: resourceNetworkingSecGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     securityGroupRuleResource,
+				Set:      hashSecurityGroupRule,
+			},
+		},
+	}
+}
+
+func hashSecurityGroupRule(v interface{}) int {
+	rule, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	// The missing parameters (such as the ports of the remote rules) are regarded as empty values.
+	keys := []string{"direction", "ethertype", "protocol", "ports", "remote_ip_prefix", "remote_group_id",
+		"remote_address_group_id", "action", "priority", "description"}
+	var buf strings.Builder
+	for _, key := range keys {
+		if val, ok := rule[key]; ok && val != nil {
+			// The IPv6 CIDR returned by the API is in lower case.
+			if key == "remote_ip_prefix" {
+				buf.WriteString(strings.ToLower(val.(string)))
+			} else {
+				buf.WriteString(fmt.Sprint(val))
+			}
+		}
+		buf.WriteString("-")
+	}
+	return schema.HashString(buf.String())
+}
+
+func newSecurityGroupRuleSet(rules []map[string]interface{}) *schema.Set {
+	result := schema.NewSet(hashSecurityGroupRule, nil)
+	for _, rule := range rules {
+		result.Add(rule)
+	}
+	return result
+}
+
+func buildSecurityGroupRulesBodyParams(rules []interface{}) map[string]interface{} {
+	createRules := make([]map[string]interface{}, 0, len(rules))
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		createRules = append(createRules, map[string]interface{}{
+			"direction":               rule["direction"],
+			"ethertype":               rule["ethertype"],
+			"protocol":                utils.ValueIngoreEmpty(rule["protocol"]),
+			"multiport":               utils.ValueIngoreEmpty(rule["ports"]),
+			"remote_ip_prefix":        utils.ValueIngoreEmpty(rule["remote_ip_prefix"]),
+			"remote_group_id":         utils.ValueIngoreEmpty(rule["remote_group_id"]),
+			"remote_address_group_id": utils.ValueIngoreEmpty(rule["remote_address_group_id"]),
+			"action":                  utils.ValueIngoreEmpty(rule["action"]),
+			"priority":                utils.ValueIngoreEmpty(rule["priority"]),
+			"description":             utils.ValueIngoreEmpty(rule["description"]),
+		})
+	}
+	return map[string]interface{}{
+		"security_group_rules": createRules,
+	}
+}
+
+func batchCreateSecurityGroupRules(client *golangsdk.ServiceClient, securityGroupId string, rules []interface{}) error {
+	createPath := client.ResourceBaseURL() + fmt.Sprintf("vpc/security-groups/%s/security-group-rules/batch-create",
+		securityGroupId)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildSecurityGroupRulesBodyParams(rules)),
+		OkCodes: []int{
+			201,
+		},
+	}
+	_, err := client.Request("POST", createPath, &createOpt)
+	return err
+}
+
+// updateSecurityGroupRules reconciles the rules of the security group from the old set to the new set. The old set is
+// expected to be the refreshed remote rules, so the rules that are added out of Terraform are removed as well.
+func updateSecurityGroupRules(client *golangsdk.ServiceClient, securityGroupId string, oldRules,
+	newRules *schema.Set) error {
+	for _, v := range oldRules.Difference(newRules).List() {
+		ruleId := v.(map[string]interface{})["id"].(string)
+		if ruleId == "" {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting the rule (%s) of the security group (%s)", ruleId, securityGroupId)
+		err := v3rules.Delete(client, ruleId).ExtractErr()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting rule (%s) of security group (%s): %s", ruleId, securityGroupId, err)
+		}
+	}
+
+	createRules := newRules.Difference(oldRules).List()
+	if len(createRules) < 1 {
+		return nil
+	}
+	log.Printf("[DEBUG] Creating %d rules for the security group (%s)", len(createRules), securityGroupId)
+	if err := batchCreateSecurityGroupRules(client, securityGroupId, createRules); err != nil {
+		return fmt.Errorf("error creating rules for security group (%s): %s", securityGroupId, err)
+	}
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating networking v3 client: %s", err)
+	}
+
+	securityGroupId := d.Get("security_group_id").(string)
+	securityGroup, err := v3groups.Get(client, securityGroupId)
+	if err != nil {
+		return diag.Errorf("error retrieving security group (%s): %s", securityGroupId, err)
+	}
+	remoteRules, err := flattenSecurityGroupRulesV3(securityGroup.SecurityGroupRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateSecurityGroupRules(client, securityGroupId, newSecurityGroupRuleSet(remoteRules),
+		d.Get("rules").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(securityGroupId)
+
+	return resourceNetworkingSecGroupRulesRead(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking v3 client: %s", err)
+	}
+
+	securityGroup, err := v3groups.Get(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "Security group rules")
+	}
+	rules, err := flattenSecurityGroupRulesV3(securityGroup.SecurityGroupRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("security_group_id", securityGroup.ID),
+		d.Set("rules", newSecurityGroupRuleSet(rules)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceNetworkingSecGroupRulesUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating networking v3 client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("rules")
+	err = updateSecurityGroupRules(client, d.Id(), oldRaw.(*schema.Set), newRaw.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetworkingSecGroupRulesRead(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating networking v3 client: %s", err)
+	}
+
+	err = updateSecurityGroupRules(client, d.Id(), d.Get("rules").(*schema.Set),
+		schema.NewSet(hashSecurityGroupRule, nil))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
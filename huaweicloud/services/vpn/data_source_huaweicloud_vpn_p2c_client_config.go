@@ -0,0 +1,111 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API VPN POST /v5/{project_id}/p2c-vpn-gateways/vpn-servers/{vpn_server_id}/client-config/export
+func DataSourceP2CClientConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceP2CClientConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the VPN server is located.`,
+			},
+			"vpn_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the VPN server.`,
+			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_private_key"},
+				Description:  `The client certificate, in PEM format, to be embedded in the OpenVPN profile.`,
+			},
+			"client_private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: `The private key of the client certificate, in PEM format.`,
+			},
+			// Attributes
+			"client_config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The client configuration exported by the VPN server.`,
+			},
+			"openvpn_profile": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: `The OpenVPN profile which can be imported into the OpenVPN clients.`,
+			},
+		},
+	}
+}
+
+// buildOpenVPNProfile embeds the client certificate and private key into the exported configuration, so that the
+// profile can be used directly by the clients authenticated by certificates.
+func buildOpenVPNProfile(clientConfig, certificate, privateKey string) string {
+	if certificate == "" {
+		return clientConfig
+	}
+
+	var profile strings.Builder
+	profile.WriteString(strings.TrimRight(clientConfig, "\n"))
+	profile.WriteString(fmt.Sprintf("\n<cert>\n%s\n</cert>\n", strings.TrimSpace(certificate)))
+	profile.WriteString(fmt.Sprintf("<key>\n%s\n</key>\n", strings.TrimSpace(privateKey)))
+	return profile.String()
+}
+
+func dataSourceP2CClientConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	serverId := d.Get("vpn_server_id").(string)
+	exportPath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/vpn-servers/{vpn_server_id}/client-config/export"
+	exportPath = strings.ReplaceAll(exportPath, "{project_id}", client.ProjectID)
+	exportPath = strings.ReplaceAll(exportPath, "{vpn_server_id}", serverId)
+	exportOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	exportResp, err := client.Request("POST", exportPath, &exportOpt)
+	if err != nil {
+		return diag.Errorf("error exporting client configuration of VPN server (%s): %s", serverId, err)
+	}
+	exportRespBody, err := utils.FlattenResponse(exportResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverId)
+
+	clientConfig := utils.PathSearch("client_config", exportRespBody, "").(string)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("client_config", clientConfig),
+		d.Set("openvpn_profile", buildOpenVPNProfile(clientConfig, d.Get("client_certificate").(string),
+			d.Get("client_private_key").(string))),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,408 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API VPN POST /v5/{project_id}/p2c-vpn-gateways
+// @API VPN GET /v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}
+// @API VPN PUT /v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}
+// @API VPN DELETE /v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}
+// @API VPN POST /v5/{project_id}/{resource_type}/{resource_id}/tags/create
+// @API VPN DELETE /v5/{project_id}/{resource_type}/{resource_id}/tags/delete
+func ResourceP2CGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceP2CGatewayCreate,
+		UpdateContext: resourceP2CGatewayUpdate,
+		ReadContext:   resourceP2CGatewayRead,
+		DeleteContext: resourceP2CGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\-_A-Za-z0-9]+$`),
+						"the input is invalid"),
+					validation.StringLenBetween(1, 64),
+				),
+				Description: `The name of the P2C VPN gateway.`,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the VPC to which the P2C VPN gateway connects.`,
+			},
+			"connect_subnet": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the VPC subnet used by the P2C VPN gateway.`,
+			},
+			"availability_zones": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The list of availability zone IDs of the P2C VPN gateway.`,
+			},
+			"eip": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"eip.0.id", "eip.0.type"},
+							Description:  `The ID of the EIP used by the P2C VPN gateway.`,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"eip.0.bandwidth_size", "eip.0.charge_mode"},
+							Description:  `The type of the EIP to be created.`,
+						},
+						"bandwidth_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: `The bandwidth name of the EIP to be created.`,
+						},
+						"bandwidth_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: `The bandwidth size of the EIP to be created, in Mbit/s.`,
+						},
+						"charge_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"bandwidth", "traffic"}, false),
+							Description:  `The charge mode of the bandwidth of the EIP to be created.`,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The IP address of the EIP.`,
+						},
+					},
+				},
+				Description: `The EIP configuration of the P2C VPN gateway.`,
+			},
+			"flavor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The flavor of the P2C VPN gateway.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The enterprise project ID of the P2C VPN gateway.`,
+			},
+			"tags": common.TagsSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the P2C VPN gateway.`,
+			},
+			"max_connection_number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The maximum number of concurrent client connections.`,
+			},
+			"current_connection_number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of current client connections.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the P2C VPN gateway.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the P2C VPN gateway.`,
+			},
+		},
+	}
+}
+
+func buildCreateP2CGatewayBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	var eip map[string]interface{}
+	if rawArray := d.Get("eip").([]interface{}); len(rawArray) > 0 {
+		raw := rawArray[0].(map[string]interface{})
+		eip = map[string]interface{}{
+			"id":             utils.ValueIngoreEmpty(raw["id"]),
+			"type":           utils.ValueIngoreEmpty(raw["type"]),
+			"bandwidth_name": utils.ValueIngoreEmpty(raw["bandwidth_name"]),
+			"bandwidth_size": utils.ValueIngoreEmpty(raw["bandwidth_size"]),
+			"charge_mode":    utils.ValueIngoreEmpty(raw["charge_mode"]),
+		}
+	}
+
+	return map[string]interface{}{
+		"p2c_vpn_gateway": map[string]interface{}{
+			"name":                  d.Get("name"),
+			"vpc_id":                d.Get("vpc_id"),
+			"connect_subnet":        d.Get("connect_subnet"),
+			"availability_zone_ids": d.Get("availability_zones"),
+			"eip":                   eip,
+			"flavor":                utils.ValueIngoreEmpty(d.Get("flavor")),
+			"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+			"tags":                  utils.ValueIngoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+		},
+	}
+}
+
+func resourceP2CGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildCreateP2CGatewayBodyParams(d, cfg)),
+		OkCodes: []int{
+			201,
+		},
+	}
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating P2C VPN gateway: %s", err)
+	}
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("p2c_vpn_gateway.id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating P2C VPN gateway: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	err = waitForP2CGatewayStatus(ctx, client, d.Id(), []string{"PENDING_CREATE"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for creating P2C VPN gateway (%s) to complete: %s", d.Id(), err)
+	}
+	return resourceP2CGatewayRead(ctx, d, meta)
+}
+
+func getP2CGateway(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	getPath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{p2c_vgw_id}", id)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("p2c_vpn_gateway", getRespBody, nil), nil
+}
+
+// waitForP2CGatewayStatus waits for the gateway to leave the pending statuses, an empty pending list means waiting
+// for the deletion.
+func waitForP2CGatewayStatus(ctx context.Context, client *golangsdk.ServiceClient, id string, pending []string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			gateway, err := getP2CGateway(client, id)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok && len(pending) < 1 {
+					return "deleted", "COMPLETED", nil
+				}
+				return nil, "ERROR", err
+			}
+
+			status := utils.PathSearch("status", gateway, "").(string)
+			if len(pending) < 1 || utils.StrSliceContains(pending, status) {
+				return gateway, "PENDING", nil
+			}
+			if status == "ACTIVE" {
+				return gateway, "COMPLETED", nil
+			}
+			return gateway, "ERROR", fmt.Errorf("unexpected status '%s'", status)
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func flattenP2CGatewayEip(gateway interface{}) []map[string]interface{} {
+	eip := utils.PathSearch("eip", gateway, nil)
+	if eip == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"id":             utils.PathSearch("id", eip, nil),
+			"type":           utils.PathSearch("type", eip, nil),
+			"bandwidth_name": utils.PathSearch("bandwidth_name", eip, nil),
+			"bandwidth_size": utils.PathSearch("bandwidth_size", eip, nil),
+			"charge_mode":    utils.PathSearch("charge_mode", eip, nil),
+			"ip_address":     utils.PathSearch("ip_address", eip, nil),
+		},
+	}
+}
+
+func resourceP2CGatewayRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	gateway, err := getP2CGateway(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving P2C VPN gateway")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", gateway, nil)),
+		d.Set("vpc_id", utils.PathSearch("vpc_id", gateway, nil)),
+		d.Set("connect_subnet", utils.PathSearch("connect_subnet", gateway, nil)),
+		d.Set("availability_zones", utils.PathSearch("availability_zone_ids", gateway, nil)),
+		d.Set("eip", flattenP2CGatewayEip(gateway)),
+		d.Set("flavor", utils.PathSearch("flavor", gateway, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", gateway, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("tags", gateway, nil))),
+		d.Set("status", utils.PathSearch("status", gateway, nil)),
+		d.Set("max_connection_number", utils.PathSearch("max_connection_number", gateway, nil)),
+		d.Set("current_connection_number", utils.PathSearch("current_connection_number", gateway, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", gateway, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", gateway, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceP2CGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updatePath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}"
+		updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+		updatePath = strings.ReplaceAll(updatePath, "{p2c_vgw_id}", d.Id())
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"p2c_vpn_gateway": map[string]interface{}{
+					"name": d.Get("name"),
+				},
+			},
+		}
+		_, err = client.Request("PUT", updatePath, &updateOpt)
+		if err != nil {
+			return diag.Errorf("error updating P2C VPN gateway (%s): %s", d.Id(), err)
+		}
+
+		err = waitForP2CGatewayStatus(ctx, client, d.Id(), []string{"PENDING_UPDATE"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for updating P2C VPN gateway (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err = updateTags(client, d, "p2c-vpn-gateway", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of P2C VPN gateway (%s): %s", d.Id(), err)
+		}
+	}
+	return resourceP2CGatewayRead(ctx, d, meta)
+}
+
+func resourceP2CGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{p2c_vgw_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return diag.Errorf("error deleting P2C VPN gateway: %s", err)
+	}
+
+	err = waitForP2CGatewayStatus(ctx, client, d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for deleting P2C VPN gateway (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
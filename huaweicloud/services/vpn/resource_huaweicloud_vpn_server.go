@@ -0,0 +1,400 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The VPN server is created along with the P2C VPN gateway, the resource configures it and leaves it to the gateway
+// when it is destroyed.
+
+// @API VPN GET /v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}/vpn-servers
+// @API VPN PUT /v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}/vpn-servers/{vpn_server_id}
+func ResourceServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerCreate,
+		UpdateContext: resourceServerUpdate,
+		ReadContext:   resourceServerRead,
+		DeleteContext: resourceServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServerImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"p2c_vgw_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the P2C VPN gateway to which the VPN server belongs.`,
+			},
+			"local_subnets": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The list of local CIDR blocks which can be accessed by the clients.`,
+			},
+			"client_cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.ValidateCIDR,
+				Description:  `The CIDR block from which the client IP addresses are assigned.`,
+			},
+			"server_certificate_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the server certificate issued by the private CA.`,
+			},
+			"client_auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CERT",
+				ValidateFunc: validation.StringInSlice([]string{"CERT", "LOCAL_PASSWORD"}, false),
+				Description:  `The authentication type of the clients.`,
+			},
+			"client_ca_certificates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The name of the client CA certificate.`,
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The content of the client CA certificate, in PEM format.`,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the client CA certificate.`,
+						},
+						"expiration_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The expiration time of the client CA certificate.`,
+						},
+					},
+				},
+				Description: `The CA certificates used to authenticate the client certificates.`,
+			},
+			"ssl_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"TCP", "UDP"}, false),
+							Description:  `The transport protocol of the tunnel.`,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `The port of the tunnel.`,
+						},
+						"encryption_algorithm": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `The encryption algorithm of the tunnel.`,
+						},
+						"authentication_algorithm": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `The authentication algorithm of the tunnel.`,
+						},
+						"is_compressed": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: `Whether to compress the tunnel traffic.`,
+						},
+					},
+				},
+				Description: `The SSL options of the tunnel.`,
+			},
+			"tunnel_protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The protocol of the tunnel.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the VPN server.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the VPN server.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the VPN server.`,
+			},
+		},
+	}
+}
+
+func getServerByGateway(client *golangsdk.ServiceClient, gatewayId string) (interface{}, error) {
+	listPath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}/vpn-servers"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{p2c_vgw_id}", gatewayId)
+
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	listResp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	listRespBody, err := utils.FlattenResponse(listResp)
+	if err != nil {
+		return nil, err
+	}
+
+	server := utils.PathSearch("vpn_servers|[0]", listRespBody, nil)
+	if server == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return server, nil
+}
+
+func buildServerClientCACertificatesBodyParams(d *schema.ResourceData) []map[string]interface{} {
+	rawArray := d.Get("client_ca_certificates").([]interface{})
+	result := make([]map[string]interface{}, 0, len(rawArray))
+	for _, v := range rawArray {
+		raw := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"name":    raw["name"],
+			"content": raw["content"],
+		})
+	}
+	return result
+}
+
+func buildServerSSLOptionsBodyParams(d *schema.ResourceData) map[string]interface{} {
+	rawArray := d.Get("ssl_options").([]interface{})
+	if len(rawArray) < 1 || rawArray[0] == nil {
+		return nil
+	}
+	raw := rawArray[0].(map[string]interface{})
+	return map[string]interface{}{
+		"protocol":                 utils.ValueIngoreEmpty(raw["protocol"]),
+		"port":                     utils.ValueIngoreEmpty(raw["port"]),
+		"encryption_algorithm":     utils.ValueIngoreEmpty(raw["encryption_algorithm"]),
+		"authentication_algorithm": utils.ValueIngoreEmpty(raw["authentication_algorithm"]),
+		"is_compressed":            raw["is_compressed"],
+	}
+}
+
+func buildUpdateServerBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"local_subnets":    utils.ExpandToStringList(d.Get("local_subnets").([]interface{})),
+		"client_cidr":      d.Get("client_cidr"),
+		"client_auth_type": d.Get("client_auth_type"),
+		"server_certificate": map[string]interface{}{
+			"id": d.Get("server_certificate_id"),
+		},
+		"ssl_options": buildServerSSLOptionsBodyParams(d),
+	}
+	if d.HasChange("client_ca_certificates") {
+		params["client_ca_certificates"] = buildServerClientCACertificatesBodyParams(d)
+	}
+	return map[string]interface{}{
+		"vpn_server": params,
+	}
+}
+
+func updateServer(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeout time.Duration) error {
+	gatewayId := d.Get("p2c_vgw_id").(string)
+	updatePath := client.Endpoint + "v5/{project_id}/p2c-vpn-gateways/{p2c_vgw_id}/vpn-servers/{vpn_server_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{p2c_vgw_id}", gatewayId)
+	updatePath = strings.ReplaceAll(updatePath, "{vpn_server_id}", d.Id())
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildUpdateServerBodyParams(d)),
+	}
+	_, err := client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating VPN server (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			server, err := getServerByGateway(client, gatewayId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+
+			status := utils.PathSearch("status", server, "").(string)
+			log.Printf("[DEBUG] The status of the VPN server (%s) is: %s", d.Id(), status)
+			switch status {
+			case "ACTIVE":
+				return server, "COMPLETED", nil
+			case "PENDING_UPDATE":
+				return server, "PENDING", nil
+			default:
+				return server, "ERROR", fmt.Errorf("unexpected status '%s'", status)
+			}
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for updating VPN server (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	gatewayId := d.Get("p2c_vgw_id").(string)
+	server, err := getServerByGateway(client, gatewayId)
+	if err != nil {
+		return diag.Errorf("error retrieving VPN server of P2C VPN gateway (%s): %s", gatewayId, err)
+	}
+	d.SetId(utils.PathSearch("id", server, "").(string))
+
+	if err = updateServer(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceServerRead(ctx, d, meta)
+}
+
+func flattenServerClientCACertificates(d *schema.ResourceData, server interface{}) []map[string]interface{} {
+	certificates := utils.PathSearch("client_ca_certificates", server, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(certificates))
+	for i, certificate := range certificates {
+		result[i] = map[string]interface{}{
+			"id":              utils.PathSearch("id", certificate, nil),
+			"name":            utils.PathSearch("name", certificate, nil),
+			"expiration_time": utils.PathSearch("expiration_time", certificate, nil),
+			// The content of the certificate is not returned.
+			"content": d.Get(fmt.Sprintf("client_ca_certificates.%d.content", i)),
+		}
+	}
+	return result
+}
+
+func flattenServerSSLOptions(server interface{}) []map[string]interface{} {
+	options := utils.PathSearch("ssl_options", server, nil)
+	if options == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"protocol":                 utils.PathSearch("protocol", options, nil),
+			"port":                     utils.PathSearch("port", options, nil),
+			"encryption_algorithm":     utils.PathSearch("encryption_algorithm", options, nil),
+			"authentication_algorithm": utils.PathSearch("authentication_algorithm", options, nil),
+			"is_compressed":            utils.PathSearch("is_compressed", options, nil),
+		},
+	}
+}
+
+func resourceServerRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	server, err := getServerByGateway(client, d.Get("p2c_vgw_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPN server")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("local_subnets", utils.PathSearch("local_subnets", server, nil)),
+		d.Set("client_cidr", utils.PathSearch("client_cidr", server, nil)),
+		d.Set("server_certificate_id", utils.PathSearch("server_certificate.id", server, nil)),
+		d.Set("client_auth_type", utils.PathSearch("client_auth_type", server, nil)),
+		d.Set("client_ca_certificates", flattenServerClientCACertificates(d, server)),
+		d.Set("ssl_options", flattenServerSSLOptions(server)),
+		d.Set("tunnel_protocol", utils.PathSearch("tunnel_protocol", server, nil)),
+		d.Set("status", utils.PathSearch("status", server, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", server, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", server, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	if err = updateServer(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceServerRead(ctx, d, meta)
+}
+
+func resourceServerDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The VPN server (%s) is removed from the state only, it will be deleted with the P2C VPN "+
+		"gateway", d.Id())
+	return nil
+}
+
+func resourceServerImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<p2c_vgw_id>/<id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("p2c_vgw_id", parts[0])
+}
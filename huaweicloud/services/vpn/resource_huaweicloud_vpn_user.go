@@ -0,0 +1,235 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// @API VPN POST /v5/{project_id}/vpn-servers/{vpn_server_id}/users
+// @API VPN GET /v5/{project_id}/vpn-servers/{vpn_server_id}/users/{user_id}
+// @API VPN PUT /v5/{project_id}/vpn-servers/{vpn_server_id}/users/{user_id}
+// @API VPN DELETE /v5/{project_id}/vpn-servers/{vpn_server_id}/users/{user_id}
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		UpdateContext: resourceUserUpdate,
+		ReadContext:   resourceUserRead,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpn_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the VPN server to which the user belongs.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\-_.A-Za-z0-9]+$`),
+						"the input is invalid"),
+					validation.StringLenBetween(1, 64),
+				),
+				Description: `The name of the user.`,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `The password of the user.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description of the user.`,
+			},
+			"user_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the user group to which the user belongs.`,
+			},
+			"user_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the user group to which the user belongs.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the user.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the user.`,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v5/{project_id}/vpn-servers/{vpn_server_id}/users"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createPath = strings.ReplaceAll(createPath, "{vpn_server_id}", d.Get("vpn_server_id").(string))
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"user": utils.RemoveNil(map[string]interface{}{
+				"name":          d.Get("name"),
+				"password":      d.Get("password"),
+				"description":   utils.ValueIngoreEmpty(d.Get("description")),
+				"user_group_id": utils.ValueIngoreEmpty(d.Get("user_group_id")),
+			}),
+		},
+		OkCodes: []int{
+			201,
+		},
+	}
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating VPN user: %s", err)
+	}
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("user.id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the VPN user ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v5/{project_id}/vpn-servers/{vpn_server_id}/users/{user_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{vpn_server_id}", d.Get("vpn_server_id").(string))
+	getPath = strings.ReplaceAll(getPath, "{user_id}", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPN user")
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user := utils.PathSearch("user", getRespBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", user, nil)),
+		d.Set("description", utils.PathSearch("description", user, nil)),
+		d.Set("user_group_id", utils.PathSearch("user_group_id", user, nil)),
+		d.Set("user_group_name", utils.PathSearch("user_group_name", user, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", user, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", user, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v5/{project_id}/vpn-servers/{vpn_server_id}/users/{user_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{vpn_server_id}", d.Get("vpn_server_id").(string))
+	updatePath = strings.ReplaceAll(updatePath, "{user_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"user": map[string]interface{}{
+				"description":   d.Get("description"),
+				"user_group_id": d.Get("user_group_id"),
+			},
+		},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating VPN user (%s): %s", d.Id(), err)
+	}
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v5/{project_id}/vpn-servers/{vpn_server_id}/users/{user_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{vpn_server_id}", d.Get("vpn_server_id").(string))
+	deletePath = strings.ReplaceAll(deletePath, "{user_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting VPN user")
+	}
+	return nil
+}
+
+func resourceUserImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<vpn_server_id>/<id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("vpn_server_id", parts[0])
+}